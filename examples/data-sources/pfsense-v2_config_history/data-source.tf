@@ -0,0 +1,5 @@
+data "pfsense-v2_config_history" "this" {}
+
+output "latest_change" {
+  value = "${data.pfsense-v2_config_history.this.revisions[0].time}: ${data.pfsense-v2_config_history.this.revisions[0].description}"
+}
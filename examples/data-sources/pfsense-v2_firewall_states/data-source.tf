@@ -0,0 +1,19 @@
+# Connections from one LAN host
+data "pfsense-v2_firewall_states" "workstation" {
+  interface = "lan"
+  source    = "192.168.1.10:"
+}
+
+output "workstation_connections" {
+  value = [for s in data.pfsense-v2_firewall_states.workstation.states : "${s.protocol} ${s.source} -> ${s.destination} (${s.state})"]
+}
+
+# Cut off established connections as soon as the rule is tightened
+resource "pfsense-v2_firewall_rule" "block_guest_to_lan" {
+  type                  = "block"
+  interfaces            = ["opt1"]
+  source                = "opt1"
+  destination           = "lan"
+  description           = "Block guests from LAN"
+  kill_states_on_change = true
+}
@@ -0,0 +1,9 @@
+data "pfsense-v2_packages" "this" {}
+
+output "installed_packages" {
+  value = { for p in data.pfsense-v2_packages.this.installed : p.name => p.installed_version }
+}
+
+output "outdated_packages" {
+  value = [for p in data.pfsense-v2_packages.this.installed : p.name if p.update_available]
+}
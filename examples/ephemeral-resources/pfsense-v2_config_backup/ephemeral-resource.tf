@@ -0,0 +1,14 @@
+# The whole config.xml, kept out of Terraform state
+ephemeral "pfsense-v2_config_backup" "full" {}
+
+# Only the firewall aliases
+ephemeral "pfsense-v2_config_backup" "aliases" {
+  area = "aliases"
+}
+
+# Store the backup through a write-only argument
+resource "aws_secretsmanager_secret_version" "backup" {
+  secret_id                = aws_secretsmanager_secret.backup.id
+  secret_string_wo         = ephemeral.pfsense-v2_config_backup.full.content
+  secret_string_wo_version = 1
+}
@@ -0,0 +1,7 @@
+resource "pfsense-v2_firewall_rule" "web" {
+  type             = "pass"
+  interfaces       = ["wan"]
+  protocol         = "tcp"
+  destination      = "wan:ip"
+  destination_port = provider::pfsense-v2::format_port_range(8000, 8080)
+}
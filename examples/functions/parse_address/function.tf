@@ -0,0 +1,8 @@
+locals {
+  destination = provider::pfsense-v2::parse_address("!lan:ip")
+}
+
+# { kind = "interface", value = "lan", inverted = true, interface_ip = true }
+output "destination" {
+  value = local.destination
+}
@@ -0,0 +1,7 @@
+locals {
+  web_ports = provider::pfsense-v2::port_range("8000:8080")
+}
+
+output "web_port_count" {
+  value = local.web_ports.to - local.web_ports.from + 1
+}
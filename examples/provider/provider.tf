@@ -11,4 +11,7 @@ provider "pfsense-v2" {
   insecure            = true
   api_client_username = "admin"
   api_client_token    = "1234ABCD"
+
+  # Fail the plan when a rule names an alias or interface that does not exist
+  validate_references = true
 }
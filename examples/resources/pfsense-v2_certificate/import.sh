@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_certificate.imported 0
+
+# Import by refid
+terraform import pfsense-v2_certificate.imported refid:61a4b2c3d4e5f
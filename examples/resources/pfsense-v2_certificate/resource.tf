@@ -0,0 +1,37 @@
+# A server certificate signed by a CA on the device
+resource "pfsense-v2_certificate" "webgui" {
+  method       = "internal"
+  description  = "webGUI"
+  ca_refid     = pfsense-v2_certificate_authority.internal.refid
+  common_name  = "firewall.example.com"
+  dns_names    = ["firewall.example.com"]
+  ip_addresses = ["192.168.1.1"]
+}
+
+# A signing request, signed elsewhere; set certificate once the signed PEM is available
+resource "pfsense-v2_certificate" "public" {
+  method      = "csr"
+  description = "Public"
+  common_name = "vpn.example.com"
+  dns_names   = ["vpn.example.com"]
+}
+
+output "public_csr" {
+  value = pfsense-v2_certificate.public.csr
+}
+
+variable "imported_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# An existing certificate and key; the key is never stored in state
+resource "pfsense-v2_certificate" "imported" {
+  method      = "existing"
+  description = "Imported"
+  certificate = file("${path.module}/imported.pem")
+
+  private_key_wo         = var.imported_key
+  private_key_wo_version = 1
+}
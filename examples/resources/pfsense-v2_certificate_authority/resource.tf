@@ -0,0 +1,27 @@
+# A root CA generated on the device
+resource "pfsense-v2_certificate_authority" "internal" {
+  method        = "internal"
+  description   = "Internal CA"
+  common_name   = "Example Internal CA"
+  country       = "NL"
+  organization  = "Example"
+  key_type      = "ECDSA"
+  curve         = "secp384r1"
+  random_serial = true
+}
+
+# An intermediate CA signed by it
+resource "pfsense-v2_certificate_authority" "vpn" {
+  method      = "internal"
+  description = "VPN CA"
+  common_name = "Example VPN CA"
+  ca_refid    = pfsense-v2_certificate_authority.internal.refid
+}
+
+# An existing CA, trusted by the device; the key is only needed to sign certificates on the device
+resource "pfsense-v2_certificate_authority" "corporate" {
+  method      = "existing"
+  description = "Corporate CA"
+  certificate = file("${path.module}/corporate-ca.pem")
+  trust       = true
+}
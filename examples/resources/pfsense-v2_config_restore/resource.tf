@@ -0,0 +1,11 @@
+# Revert to the snapshot taken before the last Terraform run's changes
+# (recorded when the provider is configured with config_snapshot = true)
+data "pfsense-v2_config_history" "this" {}
+
+locals {
+  snapshot = [for r in data.pfsense-v2_config_history.this.revisions : r if r.description == "Snapshot before Terraform changes"][0]
+}
+
+resource "pfsense-v2_config_restore" "revert" {
+  revision_time = local.snapshot.time
+}
@@ -0,0 +1,5 @@
+# Import by interface and pfSense ID
+terraform import pfsense-v2_dhcp_server_static_mapping.printer lan/4
+
+# Import by interface and MAC address
+terraform import pfsense-v2_dhcp_server_static_mapping.printer lan/00:11:22:33:44:55
@@ -0,0 +1,7 @@
+resource "pfsense-v2_dhcp_server_static_mapping" "printer" {
+  interface   = "lan"
+  mac_address = "00:11:22:33:44:55"
+  ip_address  = "192.168.1.20"
+  hostname    = "printer"
+  description = "Office printer"
+}
@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_dns_resolver_access_list.vpn_clients 0
+
+# Import by access list name
+terraform import pfsense-v2_dns_resolver_access_list.vpn_clients vpn_clients
@@ -0,0 +1,9 @@
+resource "pfsense-v2_dns_resolver_access_list" "vpn_clients" {
+  name        = "vpn_clients"
+  action      = "allow"
+  description = "Remote access VPN clients"
+  networks = [
+    { network = "10.8.0.0", mask = 24 },
+    { network = "fd00:8::", mask = 64, description = "IPv6 tunnel network" },
+  ]
+}
@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_dns_resolver_domain_override.office 0
+
+# Import by domain
+terraform import pfsense-v2_dns_resolver_domain_override.office office.example.com
@@ -0,0 +1,13 @@
+# Send queries for the office domain across the VPN
+resource "pfsense-v2_dns_resolver_domain_override" "office" {
+  domain      = "office.example.com"
+  server      = "10.20.0.53"
+  description = "Office DNS over the site-to-site VPN"
+}
+
+resource "pfsense-v2_dns_resolver_domain_override" "corp_tls" {
+  domain               = "corp.example.com"
+  server               = "10.30.0.53@853"
+  forward_tls_upstream = true
+  tls_hostname         = "dns.corp.example.com"
+}
@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_dns_resolver_host_override.nas 0
+
+# Import by fully qualified name
+terraform import pfsense-v2_dns_resolver_host_override.nas nas.home.arpa
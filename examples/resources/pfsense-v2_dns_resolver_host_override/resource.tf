@@ -0,0 +1,17 @@
+resource "pfsense-v2_dhcp_server_static_mapping" "nas" {
+  interface   = "lan"
+  mac_address = "00:11:22:33:44:66"
+  ip_address  = "192.168.1.30"
+  hostname    = "nas"
+}
+
+# Resolve the reserved address by name
+resource "pfsense-v2_dns_resolver_host_override" "nas" {
+  host        = pfsense-v2_dhcp_server_static_mapping.nas.hostname
+  domain      = "home.arpa"
+  ip          = [pfsense-v2_dhcp_server_static_mapping.nas.ip_address]
+  description = "Network storage"
+  aliases = [
+    { host = "files", domain = "home.arpa" },
+  ]
+}
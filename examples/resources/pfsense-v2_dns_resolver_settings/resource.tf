@@ -0,0 +1,12 @@
+resource "pfsense-v2_dns_resolver_settings" "this" {
+  active_interfaces    = ["lan", "opt1"]
+  outgoing_interfaces  = ["wan"]
+  dnssec               = true
+  forwarding           = true
+  forward_tls_upstream = true
+  register_dhcp_static = true
+  custom_options       = <<-EOT
+    server:
+      private-domain: "home.arpa"
+  EOT
+}
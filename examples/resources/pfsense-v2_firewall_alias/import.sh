@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_firewall_alias.web_servers 0
+
+# Import by alias name
+terraform import pfsense-v2_firewall_alias.web_servers web_servers
@@ -0,0 +1,7 @@
+resource "pfsense-v2_firewall_alias" "web_servers" {
+  name        = "web_servers"
+  type        = "host"
+  description = "Public web servers"
+  addresses   = ["10.0.10.10", "10.0.10.11"]
+  details     = ["web1", "web2"]
+}
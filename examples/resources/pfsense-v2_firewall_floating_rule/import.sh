@@ -0,0 +1,8 @@
+# Import by pfSense ID
+terraform import pfsense-v2_firewall_floating_rule.block_ping 0
+
+# Import by tracker
+terraform import pfsense-v2_firewall_floating_rule.block_ping tracker:1700000000
+
+# Import by description
+terraform import pfsense-v2_firewall_floating_rule.block_ping "description:Block inbound ping on WAN links"
@@ -0,0 +1,21 @@
+# Drop inbound pings on every WAN link before any interface rule is evaluated
+resource "pfsense-v2_firewall_floating_rule" "block_ping" {
+  type        = "block"
+  interfaces  = ["wan", "opt1"]
+  direction   = "in"
+  quick       = true
+  protocol    = "icmp"
+  icmptype    = ["echoreq"]
+  description = "Block inbound ping on WAN links"
+}
+
+# Send outbound VoIP traffic through a shaper queue without passing or blocking it
+resource "pfsense-v2_firewall_floating_rule" "voip_queue" {
+  type             = "match"
+  interfaces       = ["wan"]
+  direction        = "out"
+  protocol         = "udp"
+  destination_port = "5060"
+  defaultqueue     = "qVoIP"
+  description      = "Queue SIP traffic"
+}
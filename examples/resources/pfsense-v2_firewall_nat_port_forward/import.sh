@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_firewall_nat_port_forward.https 0
+
+# Import by description, which must be unique
+terraform import pfsense-v2_firewall_nat_port_forward.https "description:HTTPS to web1"
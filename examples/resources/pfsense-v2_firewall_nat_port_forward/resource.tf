@@ -0,0 +1,9 @@
+resource "pfsense-v2_firewall_nat_port_forward" "https" {
+  interface        = "wan"
+  protocol         = "tcp"
+  destination      = "wan:ip"
+  destination_port = "443"
+  target           = "10.0.10.10"
+  local_port       = "443"
+  description      = "HTTPS to web1"
+}
@@ -0,0 +1,8 @@
+# Import by pfSense ID (position in the rule list)
+terraform import pfsense-v2_firewall_rule.allow_https 3
+
+# Import by tracker, which does not change when other rules are removed
+terraform import pfsense-v2_firewall_rule.allow_https tracker:1700000000
+
+# Import by description, which must be unique
+terraform import pfsense-v2_firewall_rule.allow_https "description:Allow HTTPS out"
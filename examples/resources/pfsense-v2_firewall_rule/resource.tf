@@ -0,0 +1,18 @@
+resource "pfsense-v2_firewall_rule" "allow_https" {
+  type             = "pass"
+  interfaces       = ["lan"]
+  protocol         = "tcp"
+  source           = "lan"
+  destination      = "any"
+  destination_port = "443"
+  description      = "Allow HTTPS out"
+}
+
+# Pin traffic from the guest VLAN to the secondary WAN
+resource "pfsense-v2_firewall_rule" "guest_via_wan2" {
+  type        = "pass"
+  interfaces  = ["opt2"]
+  source      = "opt2"
+  gateway     = "WAN2_DHCP"
+  description = "Route guest VLAN via WAN2"
+}
@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_firewall_schedule.business_hours 0
+
+# Import by schedule name
+terraform import pfsense-v2_firewall_schedule.business_hours business_hours
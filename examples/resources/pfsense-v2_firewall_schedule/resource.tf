@@ -0,0 +1,26 @@
+resource "pfsense-v2_firewall_schedule" "business_hours" {
+  name        = "business_hours"
+  description = "Office opening hours"
+
+  time_ranges = [
+    {
+      weekdays    = [1, 2, 3, 4, 5]
+      hours       = "8:00-18:00"
+      description = "Weekdays"
+    },
+    {
+      # 24 and 31 December
+      months = [12, 12]
+      days   = [24, 31]
+      hours  = "8:00-12:00"
+    },
+  ]
+}
+
+resource "pfsense-v2_firewall_rule" "guest_wifi" {
+  type        = "pass"
+  interfaces  = ["opt1"]
+  source      = "opt1"
+  description = "Guest Wi-Fi during business hours"
+  sched       = pfsense-v2_firewall_schedule.business_hours.name
+}
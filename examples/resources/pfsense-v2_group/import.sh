@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_group.network_admins 1
+
+# Import by group name
+terraform import pfsense-v2_group.network_admins network-admins
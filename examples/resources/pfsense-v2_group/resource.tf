@@ -0,0 +1,5 @@
+resource "pfsense-v2_group" "network_admins" {
+  name        = "network-admins"
+  description = "Network administrators"
+  privileges  = ["page-all", "user-shell-access"]
+}
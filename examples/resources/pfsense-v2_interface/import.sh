@@ -0,0 +1,6 @@
+# Import by interface ID
+terraform import pfsense-v2_interface.guest opt2
+
+# Import by assigned device or by description
+terraform import pfsense-v2_interface.guest port:igb1.100
+terraform import pfsense-v2_interface.guest description:GUEST
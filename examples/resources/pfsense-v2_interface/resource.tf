@@ -0,0 +1,7 @@
+resource "pfsense-v2_interface" "guest" {
+  port         = "igb1.100"
+  description  = "GUEST"
+  ipv4_type    = "static"
+  ipv4_address = "10.100.0.1"
+  ipv4_subnet  = 24
+}
@@ -0,0 +1,2 @@
+# Import by package name
+terraform import pfsense-v2_package.haproxy pfSense-pkg-haproxy
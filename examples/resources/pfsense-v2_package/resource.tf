@@ -0,0 +1,11 @@
+resource "pfsense-v2_package" "haproxy" {
+  name = "pfSense-pkg-haproxy"
+}
+
+resource "pfsense-v2_package" "acme" {
+  name = "pfSense-pkg-acme"
+}
+
+output "package_updates" {
+  value = [for p in [pfsense-v2_package.haproxy, pfsense-v2_package.acme] : "${p.name} ${p.installed_version} -> ${p.latest_version}" if p.update_available]
+}
@@ -0,0 +1,2 @@
+# There is one instance per device, so any ID imports it
+terraform import pfsense-v2_restapi_settings.this restapi_settings
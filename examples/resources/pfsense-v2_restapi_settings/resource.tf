@@ -0,0 +1,18 @@
+# Only API keys, only from the LAN
+resource "pfsense-v2_restapi_settings" "this" {
+  auth_methods       = ["KeyAuth"]
+  allowed_interfaces = ["lan"]
+  login_protection   = true
+
+  ha_sync                     = true
+  ha_sync_hosts               = ["192.168.1.3"]
+  ha_sync_username            = "admin"
+  ha_sync_password_wo         = var.ha_sync_password
+  ha_sync_password_wo_version = 1
+}
+
+variable "ha_sync_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
@@ -0,0 +1,2 @@
+# There is one instance per device, so any ID imports it
+terraform import pfsense-v2_system_advanced_firewall.this system_advanced_firewall
@@ -0,0 +1,6 @@
+resource "pfsense-v2_system_advanced_firewall" "this" {
+  maximum_states          = 800000
+  optimization            = "conservative"
+  nat_reflection          = "purenat"
+  nat_reflection_outbound = true
+}
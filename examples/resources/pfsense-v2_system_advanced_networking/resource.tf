@@ -0,0 +1,5 @@
+# Virtual NICs that mangle offloaded checksums
+resource "pfsense-v2_system_advanced_networking" "this" {
+  allow_ipv6          = true
+  checksum_offloading = false
+}
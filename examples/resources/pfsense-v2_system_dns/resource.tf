@@ -0,0 +1,7 @@
+resource "pfsense-v2_system_dns" "this" {
+  servers = [
+    { address = "9.9.9.9", gateway = "WAN_DHCP" },
+    { address = "149.112.112.112", gateway = "WAN2_DHCP" },
+  ]
+  allow_override = false
+}
@@ -0,0 +1,4 @@
+resource "pfsense-v2_system_hostname" "this" {
+  hostname = "fw-branch-12"
+  domain   = "corp.example.com"
+}
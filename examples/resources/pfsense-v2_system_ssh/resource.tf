@@ -0,0 +1,5 @@
+resource "pfsense-v2_system_ssh" "this" {
+  enabled  = true
+  port     = "2222"
+  key_only = "enabled"
+}
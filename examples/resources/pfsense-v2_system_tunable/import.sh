@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_system_tunable.sendbuf_max 3
+
+# Import by sysctl name
+terraform import pfsense-v2_system_tunable.sendbuf_max net.inet.tcp.sendbuf_max
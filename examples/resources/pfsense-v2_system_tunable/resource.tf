@@ -0,0 +1,12 @@
+# Larger socket buffers for a 10G uplink
+resource "pfsense-v2_system_tunable" "sendbuf_max" {
+  name        = "net.inet.tcp.sendbuf_max"
+  value       = "16777216"
+  description = "Maximum TCP send buffer"
+}
+
+resource "pfsense-v2_system_tunable" "recvbuf_max" {
+  name        = "net.inet.tcp.recvbuf_max"
+  value       = "16777216"
+  description = "Maximum TCP receive buffer"
+}
@@ -0,0 +1,8 @@
+# The API moves with the webGUI: once this is applied, set the provider url to
+# https://<device>:8443
+resource "pfsense-v2_system_webgui" "this" {
+  protocol              = "https"
+  port                  = "8443"
+  ssl_certificate_refid = pfsense-v2_certificate.webgui.refid
+  anti_lockout          = false
+}
@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_traffic_limiter.upload 0
+
+# Import by limiter name
+terraform import pfsense-v2_traffic_limiter.upload upload
@@ -0,0 +1,24 @@
+resource "pfsense-v2_traffic_limiter" "upload" {
+  name        = "upload"
+  description = "Per-host upload limit"
+  mask        = "srcaddress"
+  scheduler   = "fq_codel"
+
+  bandwidths = [{ bandwidth = 10, scale = "Mb" }]
+}
+
+resource "pfsense-v2_traffic_limiter" "download" {
+  name = "download"
+  mask = "dstaddress"
+
+  bandwidths = [{ bandwidth = 50, scale = "Mb" }]
+}
+
+resource "pfsense-v2_firewall_rule" "limit_lan" {
+  type        = "pass"
+  interfaces  = ["lan"]
+  source      = "lan"
+  description = "Limit LAN bandwidth per host"
+  dnpipe      = pfsense-v2_traffic_limiter.upload.name
+  pdnpipe     = pfsense-v2_traffic_limiter.download.name
+}
@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_traffic_shaper.wan 0
+
+# Import by interface
+terraform import pfsense-v2_traffic_shaper.wan wan
@@ -0,0 +1,29 @@
+resource "pfsense-v2_traffic_shaper" "wan" {
+  interface = "wan"
+  scheduler = "PRIQ"
+  bandwidth = 100
+
+  queues = [
+    {
+      name     = "qDefault"
+      priority = 1
+      default  = true
+    },
+    {
+      name     = "qVoIP"
+      priority = 7
+    },
+  ]
+}
+
+resource "pfsense-v2_firewall_rule" "voip" {
+  type             = "pass"
+  interfaces       = ["lan"]
+  protocol         = "udp"
+  source           = "lan"
+  destination_port = "5060"
+  description      = "Prioritise SIP"
+  defaultqueue     = "qVoIP"
+
+  depends_on = [pfsense-v2_traffic_shaper.wan]
+}
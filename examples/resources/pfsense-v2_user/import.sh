@@ -0,0 +1,5 @@
+# Import by pfSense ID
+terraform import pfsense-v2_user.alice 1
+
+# Import by user name
+terraform import pfsense-v2_user.alice alice
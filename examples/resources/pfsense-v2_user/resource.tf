@@ -0,0 +1,18 @@
+variable "alice_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "pfsense-v2_user" "alice" {
+  name        = "alice"
+  description = "Alice Example"
+
+  # Never stored in state; bump the version to send a new password
+  password_wo         = var.alice_password
+  password_wo_version = 1
+
+  groups          = ["admins"]
+  authorized_keys = ["ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIExampleKeyOnly alice@laptop"]
+  expires         = "12/31/2027"
+}
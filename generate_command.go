@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+	"terraform-provider-pfsense-v2/internal/generate"
+)
+
+const generateUsage = `Usage: terraform-provider-pfsense-v2 generate [options]
+
+Reads firewall rules, aliases, NAT port forwards, DHCP static mappings and
+interfaces from a pfSense device and writes matching Terraform resource and
+import blocks. Authentication uses the same environment variables as the
+provider: PFSENSEV2_API_USERNAME and PFSENSEV2_API_PASSWORD, or
+PFSENSEV2_API_TOKEN.
+
+Options:
+`
+
+// runGenerate implements the `generate` subcommand.
+func runGenerate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), generateUsage)
+		flags.PrintDefaults()
+	}
+	url := flags.String("url", os.Getenv("PFSENSEV2_URL"), "URL of the pfSense device (defaults to PFSENSEV2_URL)")
+	insecure := flags.Bool("insecure", envBool("PFSENSEV2_INSECURE"), "allow insecure server connections when using SSL (defaults to PFSENSEV2_INSECURE)")
+	out := flags.String("out", "", "file to write the configuration to (defaults to stdout)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *url == "" {
+		return fmt.Errorf("no pfSense URL given; use -url or PFSENSEV2_URL")
+	}
+	auth, err := envAuth()
+	if err != nil {
+		return err
+	}
+
+	client, err := pfsense_rest_v2.NewPFSenseClientV2(*url, auth, *insecure)
+	if err != nil {
+		return fmt.Errorf("creating API client: %w", err)
+	}
+	snapshot, err := generate.Read(client)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	_, err = w.Write(generate.Render(snapshot))
+	return err
+}
+
+func envAuth() (pfsense_rest_v2.Authorization, error) {
+	username := os.Getenv("PFSENSEV2_API_USERNAME")
+	password := os.Getenv("PFSENSEV2_API_PASSWORD")
+	token := os.Getenv("PFSENSEV2_API_TOKEN")
+
+	switch {
+	case token != "" && username == "" && password == "":
+		return &pfsense_rest_v2.APIKeyAuth{APIToken: token}, nil
+	case token == "" && username != "" && password != "":
+		return &pfsense_rest_v2.BasicAuth{Username: username, Password: password}, nil
+	default:
+		return nil, fmt.Errorf("set either PFSENSEV2_API_USERNAME and PFSENSEV2_API_PASSWORD, or PFSENSEV2_API_TOKEN")
+	}
+}
+
+func envBool(name string) bool {
+	value := os.Getenv(name)
+	return value != "" && strings.ToLower(value) != "false"
+}
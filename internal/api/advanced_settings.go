@@ -0,0 +1,202 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// The REST API has no endpoints for most of System > Advanced, so these
+// settings are read from and written to config.xml through PHP on the device,
+// the way the webGUI pages save them. Flags are present or absent rather than
+// true or false, so they are exchanged as optional strings.
+
+// PFSenseAdvancedFirewallSettings are options from System > Advanced > Firewall & NAT.
+type PFSenseAdvancedFirewallSettings struct {
+	// MaximumStates and MaximumTableEntries are 0 for the size pfSense
+	// derives from the device's memory.
+	MaximumStates       int
+	MaximumTableEntries int
+	// Optimization is "normal", "high-latency", "aggressive" or "conservative".
+	Optimization string
+	// NATReflection is "disable", "proxy" or "purenat".
+	NATReflection       string
+	BINATReflection     bool
+	NATReflectionHelper bool
+}
+
+// PFSenseAdvancedNetworkingSettings are options from System > Advanced > Networking.
+type PFSenseAdvancedNetworkingSettings struct {
+	AllowIPv6              bool
+	PreferIPv4             bool
+	ChecksumOffloading     bool
+	SegmentationOffloading bool
+	LargeReceiveOffloading bool
+}
+
+var advancedFirewallKeys = []string{
+	"maximumstates", "maximumtableentries", "optimization",
+	"disablenatreflection", "enablenatreflectionpurenat", "enablebinatreflection", "enablenatreflectionhelper",
+}
+
+var advancedNetworkingKeys = []string{
+	"ipv6allow", "prefer_ipv4",
+	"disablechecksumoffloading", "disablesegmentationoffloading", "disablelargereceiveoffloading",
+}
+
+func (c *PFSenseClientV2) GetAdvancedFirewallSettings() (*PFSenseAdvancedFirewallSettings, error) {
+	values, err := c.getSystemConfig("retrieving advanced firewall settings", advancedFirewallKeys)
+	if err != nil {
+		return nil, err
+	}
+	return advancedFirewallFromConfig(values), nil
+}
+
+// UpdateAdvancedFirewallSettings saves the settings and applies the firewall
+// so they take effect, guarded by the safety check when it is enabled.
+func (c *PFSenseClientV2) UpdateAdvancedFirewallSettings(settings *PFSenseAdvancedFirewallSettings) (*PFSenseAdvancedFirewallSettings, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	err := c.setSystemConfig("updating advanced firewall settings", "Firewall & NAT advanced settings saved", settings.toConfig())
+	if err != nil {
+		return nil, err
+	}
+	if err := c.ApplyFirewall(); err != nil {
+		return nil, err
+	}
+	return c.GetAdvancedFirewallSettings()
+}
+
+func (c *PFSenseClientV2) GetAdvancedNetworkingSettings() (*PFSenseAdvancedNetworkingSettings, error) {
+	values, err := c.getSystemConfig("retrieving advanced networking settings", advancedNetworkingKeys)
+	if err != nil {
+		return nil, err
+	}
+	return advancedNetworkingFromConfig(values), nil
+}
+
+// UpdateAdvancedNetworkingSettings saves the settings and applies the
+// firewall, which applies the IPv6 setting, guarded by the safety check when
+// it is enabled. Offloading changes take effect when the
+// interfaces are next configured, e.g. after a reboot.
+func (c *PFSenseClientV2) UpdateAdvancedNetworkingSettings(settings *PFSenseAdvancedNetworkingSettings) (*PFSenseAdvancedNetworkingSettings, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	err := c.setSystemConfig("updating advanced networking settings", "Networking advanced settings saved", settings.toConfig())
+	if err != nil {
+		return nil, err
+	}
+	if err := c.ApplyFirewall(); err != nil {
+		return nil, err
+	}
+	return c.GetAdvancedNetworkingSettings()
+}
+
+func advancedFirewallFromConfig(values map[string]*string) *PFSenseAdvancedFirewallSettings {
+	settings := &PFSenseAdvancedFirewallSettings{
+		Optimization:        valueOrZero(values["optimization"]),
+		NATReflection:       "proxy",
+		BINATReflection:     values["enablebinatreflection"] != nil,
+		NATReflectionHelper: values["enablenatreflectionhelper"] != nil,
+	}
+	settings.MaximumStates, _ = strconv.Atoi(valueOrZero(values["maximumstates"]))
+	settings.MaximumTableEntries, _ = strconv.Atoi(valueOrZero(values["maximumtableentries"]))
+	switch {
+	case values["disablenatreflection"] != nil:
+		settings.NATReflection = "disable"
+	case values["enablenatreflectionpurenat"] != nil:
+		settings.NATReflection = "purenat"
+	}
+	if settings.Optimization == "" {
+		settings.Optimization = "normal"
+	}
+	return settings
+}
+
+func (s *PFSenseAdvancedFirewallSettings) toConfig() map[string]*string {
+	size := func(value int) *string {
+		if value == 0 {
+			return nil
+		}
+		return pointerTo(strconv.Itoa(value))
+	}
+	return map[string]*string{
+		"maximumstates":              size(s.MaximumStates),
+		"maximumtableentries":        size(s.MaximumTableEntries),
+		"optimization":               pointerTo(s.Optimization),
+		"disablenatreflection":       configFlag(s.NATReflection == "disable"),
+		"enablenatreflectionpurenat": configFlag(s.NATReflection == "purenat"),
+		"enablebinatreflection":      configFlag(s.BINATReflection),
+		"enablenatreflectionhelper":  configFlag(s.NATReflectionHelper),
+	}
+}
+
+// advancedNetworkingFromConfig inverts the offloading flags, which pfSense
+// stores as "disable" options.
+func advancedNetworkingFromConfig(values map[string]*string) *PFSenseAdvancedNetworkingSettings {
+	return &PFSenseAdvancedNetworkingSettings{
+		AllowIPv6:              values["ipv6allow"] != nil,
+		PreferIPv4:             values["prefer_ipv4"] != nil,
+		ChecksumOffloading:     values["disablechecksumoffloading"] == nil,
+		SegmentationOffloading: values["disablesegmentationoffloading"] == nil,
+		LargeReceiveOffloading: values["disablelargereceiveoffloading"] == nil,
+	}
+}
+
+func (s *PFSenseAdvancedNetworkingSettings) toConfig() map[string]*string {
+	return map[string]*string{
+		"ipv6allow":                     configFlag(s.AllowIPv6),
+		"prefer_ipv4":                   configFlag(s.PreferIPv4),
+		"disablechecksumoffloading":     configFlag(!s.ChecksumOffloading),
+		"disablesegmentationoffloading": configFlag(!s.SegmentationOffloading),
+		"disablelargereceiveoffloading": configFlag(!s.LargeReceiveOffloading),
+	}
+}
+
+// configFlag is the value of a config.xml flag that is set when enabled.
+func configFlag(enabled bool) *string {
+	if !enabled {
+		return nil
+	}
+	return pointerTo("yes")
+}
+
+// getSystemConfig reads the values of keys under system in config.xml. Keys
+// that are not set are nil.
+func (c *PFSenseClientV2) getSystemConfig(action string, keys []string) (map[string]*string, error) {
+	command := fmt.Sprintf(`php -r 'require_once("config.inc"); $values = []; `+
+		`foreach (json_decode(base64_decode("%s"), true) as $key) { $value = config_get_path("system/$key"); $values[$key] = $value === null ? null : strval($value); } `+
+		`echo json_encode($values);'`, encodeCommandArgument(keys))
+	output, err := c.runCommand(context.Background(), action, command)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]*string{}
+	if err := json.Unmarshal([]byte(output), &values); err != nil {
+		return nil, fmt.Errorf("%s: unexpected output %q: %w", action, output, err)
+	}
+	return values, nil
+}
+
+// setSystemConfig sets or, when nil, removes values under system in
+// config.xml and writes the configuration with description. Callers apply
+// the change through the API, so it passes the safety check.
+func (c *PFSenseClientV2) setSystemConfig(action string, description string, values map[string]*string) error {
+	command := fmt.Sprintf(`php -r 'require_once("config.inc"); `+
+		`foreach (json_decode(base64_decode("%s"), true) as $key => $value) { if ($value === null) { config_del_path("system/$key"); } else { config_set_path("system/$key", $value); } } `+
+		`write_config(base64_decode("%s"));'`,
+		encodeCommandArgument(values), base64.StdEncoding.EncodeToString([]byte(description)))
+	_, err := c.runCommand(context.Background(), action, command)
+	return err
+}
+
+// encodeCommandArgument encodes value as base64 JSON, which passes through
+// the shell and PHP string quoting unchanged.
+func encodeCommandArgument(value any) string {
+	encoded, _ := json.Marshal(value)
+	return base64.StdEncoding.EncodeToString(encoded)
+}
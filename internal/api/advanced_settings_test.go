@@ -0,0 +1,41 @@
+package pfsense_rest_v2
+
+import (
+	"testing"
+)
+
+func TestAdvancedFirewallSettings_RoundTrip(t *testing.T) {
+	for _, settings := range []PFSenseAdvancedFirewallSettings{
+		{Optimization: "normal", NATReflection: "proxy"},
+		{MaximumStates: 400000, MaximumTableEntries: 2000000, Optimization: "conservative", NATReflection: "disable"},
+		{Optimization: "aggressive", NATReflection: "purenat", BINATReflection: true, NATReflectionHelper: true},
+	} {
+		if got := *advancedFirewallFromConfig(settings.toConfig()); got != settings {
+			t.Errorf("round trip of %+v gave %+v", settings, got)
+		}
+	}
+}
+
+func TestAdvancedFirewallSettings_Defaults(t *testing.T) {
+	got := *advancedFirewallFromConfig(map[string]*string{"maximumstates": pointerTo("")})
+	want := PFSenseAdvancedFirewallSettings{Optimization: "normal", NATReflection: "proxy"}
+	if got != want {
+		t.Errorf("expected %+v for an unset configuration, got %+v", want, got)
+	}
+}
+
+func TestAdvancedNetworkingSettings_RoundTrip(t *testing.T) {
+	for _, settings := range []PFSenseAdvancedNetworkingSettings{
+		{},
+		{AllowIPv6: true, ChecksumOffloading: true, SegmentationOffloading: true, LargeReceiveOffloading: true},
+		{PreferIPv4: true, ChecksumOffloading: true},
+	} {
+		if got := *advancedNetworkingFromConfig(settings.toConfig()); got != settings {
+			t.Errorf("round trip of %+v gave %+v", settings, got)
+		}
+	}
+	// An empty flag element is still set
+	if got := advancedNetworkingFromConfig(map[string]*string{"ipv6allow": pointerTo("")}); !got.AllowIPv6 || !got.ChecksumOffloading {
+		t.Errorf("unexpected settings %+v", got)
+	}
+}
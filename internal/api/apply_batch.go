@@ -0,0 +1,58 @@
+package pfsense_rest_v2
+
+import (
+	"sync"
+)
+
+// applyBatch coalesces apply requests made while an apply is already in
+// progress. Terraform creates independent resources in parallel, and
+// restarting a service once per resource is slow and briefly interrupts it
+// each time; with applyBatch every caller that arrives during an apply shares
+// the single apply that follows it. Each caller still only returns once an
+// apply that started after its request has finished.
+type applyBatch struct {
+	mu      sync.Mutex
+	running bool
+	next    *applyRound
+}
+
+// applyRound is one apply shared by every caller waiting on it.
+type applyRound struct {
+	done    chan struct{}
+	err     error
+	waiters int
+}
+
+// Do requests an apply and waits for it. The caller that finds no apply
+// running performs it, and any rounds requested in the meantime.
+func (b *applyBatch) Do(apply func() error) error {
+	b.mu.Lock()
+	if b.next == nil {
+		b.next = &applyRound{done: make(chan struct{})}
+	}
+	round := b.next
+	if b.running {
+		round.waiters++
+		b.mu.Unlock()
+		<-round.done
+		return round.err
+	}
+	b.running = true
+	b.next = nil
+	b.mu.Unlock()
+
+	mine := round
+	for round != nil {
+		round.err = apply()
+		close(round.done)
+
+		b.mu.Lock()
+		round = b.next
+		b.next = nil
+		if round == nil {
+			b.running = false
+		}
+		b.mu.Unlock()
+	}
+	return mine.err
+}
@@ -0,0 +1,75 @@
+package pfsense_rest_v2
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestApplyBatch_CoalescesWaitingCallers(t *testing.T) {
+	var batch applyBatch
+	started := make(chan struct{})
+	release := make(chan struct{})
+	applies := 0
+
+	apply := func() error {
+		applies++
+		if applies == 1 {
+			close(started)
+			<-release
+		}
+		return nil
+	}
+
+	first := make(chan error)
+	go func() { first <- batch.Do(apply) }()
+	<-started
+
+	// Every caller arriving during the first apply shares the second one.
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := batch.Do(apply); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	waitForWaiters(&batch, 5)
+	close(release)
+
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+	if applies != 2 {
+		t.Errorf("expected 2 applies, got %d", applies)
+	}
+}
+
+func TestApplyBatch_ReturnsRoundError(t *testing.T) {
+	var batch applyBatch
+	want := errors.New("restart failed")
+
+	if err := batch.Do(func() error { return want }); !errors.Is(err, want) {
+		t.Errorf("expected %v, got %v", want, err)
+	}
+	if err := batch.Do(func() error { return nil }); err != nil {
+		t.Errorf("error leaked into the next round: %v", err)
+	}
+}
+
+// waitForWaiters blocks until n callers have queued behind the running apply.
+func waitForWaiters(batch *applyBatch, n int) {
+	for {
+		batch.mu.Lock()
+		queued := batch.next != nil && batch.next.waiters == n
+		batch.mu.Unlock()
+		if queued {
+			return
+		}
+		runtime.Gosched()
+	}
+}
@@ -0,0 +1,127 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseCertificateAuthority is a CA known to the device. RefID is the
+// stable identifier other configuration (OpenVPN, IPsec, the webGUI, other
+// CAs and certificates) refers to it by.
+type PFSenseCertificateAuthority struct {
+	ID          int
+	RefID       string
+	Description string
+	// Trust adds the CA to the operating system trust store.
+	Trust        bool
+	RandomSerial bool
+	Certificate  string
+	// PrivateKey is only sent, never read back. Leaving it empty on update
+	// keeps the current key.
+	PrivateKey string
+	// CARefID is the CA that signed this one, for intermediate CAs.
+	CARefID string
+}
+
+func (c *PFSenseClientV2) GetCertificateAuthorities() ([]*PFSenseCertificateAuthority, error) {
+	limit := 0
+	response, err := c.apiClient.GetSystemCertificateAuthoritiesEndpointWithResponse(
+		context.Background(),
+		&GetSystemCertificateAuthoritiesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving certificate authorities", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseCertificateAuthority{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, CertificateAuthorityFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetCertificateAuthority(id int) (*PFSenseCertificateAuthority, error) {
+	response, err := c.apiClient.GetSystemCertificateAuthorityEndpointWithResponse(
+		context.Background(),
+		&GetSystemCertificateAuthorityEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving certificate authority", response.StatusCode(), response.Body)
+	}
+	return CertificateAuthorityFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateCertificateAuthority(item *PFSenseCertificateAuthority) (*PFSenseCertificateAuthority, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostSystemCertificateAuthorityEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating certificate authority", response.StatusCode(), response.Body)
+	}
+	return CertificateAuthorityFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateCertificateAuthority(item *PFSenseCertificateAuthority) (*PFSenseCertificateAuthority, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchSystemCertificateAuthorityEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating certificate authority", response.StatusCode(), response.Body)
+	}
+	return CertificateAuthorityFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteCertificateAuthority(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteSystemCertificateAuthorityEndpointWithResponse(
+		context.Background(),
+		&DeleteSystemCertificateAuthorityEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting certificate authority", response.StatusCode(), response.Body)
+	}
+	return nil
+}
+
+// GenerateCertificateAuthority creates a CA with a new key on the device and
+// returns it.
+func (c *PFSenseClientV2) GenerateCertificateAuthority(ca *PFSenseCertificateAuthority, request *PFSenseCertificateRequest) (*PFSenseCertificateAuthority, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostSystemCertificateAuthorityGenerateEndpointWithResponse(context.Background(), ca.GenerateAPI(request))
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("generating certificate authority", response.StatusCode(), response.Body)
+	}
+	return c.GetCertificateAuthority(valueOrZero(response.JSON200.Data.Id))
+}
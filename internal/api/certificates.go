@@ -0,0 +1,172 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseCertificate is a certificate known to the device, or a signing
+// request awaiting its certificate. RefID is the stable identifier other
+// configuration refers to it by.
+type PFSenseCertificate struct {
+	ID          int
+	RefID       string
+	Description string
+	// Type is "server" or "user".
+	Type string
+	// CSR is set while the certificate was requested on the device.
+	CSR         string
+	Certificate string
+	// PrivateKey is only sent, never read back. Leaving it empty on update
+	// keeps the current key.
+	PrivateKey string
+	CARefID    string
+}
+
+// PFSenseCertificateRequest holds the parameters of a key and certificate
+// generated on the device.
+type PFSenseCertificateRequest struct {
+	// KeyType is "RSA" or "ECDSA"; KeyLength applies to RSA keys and
+	// Curve to ECDSA keys.
+	KeyType         string
+	KeyLength       int
+	Curve           string
+	DigestAlgorithm string
+	LifetimeDays    int
+	Subject         PFSenseCertificateSubject
+	// DNSNames and IPAddresses are subject alternative names, only used for
+	// certificates.
+	DNSNames    []string
+	IPAddresses []string
+	// Intermediate makes a generated CA an intermediate signed by the
+	// CARefID of the CA.
+	Intermediate bool
+}
+
+type PFSenseCertificateSubject struct {
+	CommonName         string
+	Country            string
+	State              string
+	City               string
+	Organization       string
+	OrganizationalUnit string
+}
+
+func (c *PFSenseClientV2) GetCertificates() ([]*PFSenseCertificate, error) {
+	limit := 0
+	response, err := c.apiClient.GetSystemCertificatesEndpointWithResponse(
+		context.Background(),
+		&GetSystemCertificatesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving certificates", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseCertificate{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, CertificateFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetCertificate(id int) (*PFSenseCertificate, error) {
+	response, err := c.apiClient.GetSystemCertificateEndpointWithResponse(
+		context.Background(),
+		&GetSystemCertificateEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving certificate", response.StatusCode(), response.Body)
+	}
+	return CertificateFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateCertificate(item *PFSenseCertificate) (*PFSenseCertificate, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostSystemCertificateEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating certificate", response.StatusCode(), response.Body)
+	}
+	return CertificateFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateCertificate(item *PFSenseCertificate) (*PFSenseCertificate, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchSystemCertificateEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating certificate", response.StatusCode(), response.Body)
+	}
+	return CertificateFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteCertificate(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteSystemCertificateEndpointWithResponse(
+		context.Background(),
+		&DeleteSystemCertificateEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting certificate", response.StatusCode(), response.Body)
+	}
+	return nil
+}
+
+// GenerateCertificate creates a key and a certificate signed by the
+// certificate's CARefID on the device and returns it.
+func (c *PFSenseClientV2) GenerateCertificate(certificate *PFSenseCertificate, request *PFSenseCertificateRequest) (*PFSenseCertificate, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostSystemCertificateGenerateEndpointWithResponse(context.Background(), certificate.GenerateAPI(request))
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("generating certificate", response.StatusCode(), response.Body)
+	}
+	return c.GetCertificate(valueOrZero(response.JSON200.Data.Id))
+}
+
+// CreateCertificateSigningRequest creates a key and a signing request on the
+// device. The signed certificate is added later with UpdateCertificate.
+func (c *PFSenseClientV2) CreateCertificateSigningRequest(certificate *PFSenseCertificate, request *PFSenseCertificateRequest) (*PFSenseCertificate, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostSystemCertificateSigningRequestEndpointWithResponse(context.Background(), certificate.SigningRequestAPI(request))
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating certificate signing request", response.StatusCode(), response.Body)
+	}
+	return c.GetCertificate(valueOrZero(response.JSON200.Data.Id))
+}
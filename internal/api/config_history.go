@@ -0,0 +1,116 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// PFSenseConfigRevision is a backup of config.xml kept by the device. pfSense
+// backs up the previous configuration whenever it writes a new one, so each
+// revision is the configuration as it was until the change following it.
+type PFSenseConfigRevision struct {
+	ID int
+	// Time is when the configuration was written, in seconds since the Unix
+	// epoch. It identifies the backup file.
+	Time        int
+	Description string
+	Version     string
+	Size        int
+}
+
+func (c *PFSenseClientV2) GetConfigRevisions() ([]*PFSenseConfigRevision, error) {
+	limit := 0
+	response, err := c.apiClient.GetDiagnosticsConfigHistoryRevisionsEndpointWithResponse(
+		context.Background(),
+		&GetDiagnosticsConfigHistoryRevisionsEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving configuration revisions", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseConfigRevision{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, ConfigRevisionFromAPI(item))
+	}
+
+	return items, nil
+}
+
+// RestoreConfigRevision replaces the configuration with the revision written
+// at time and reloads the device with it, as Diagnostics > Backup & Restore >
+// Config History does. The REST API has no endpoint for this, so it goes
+// through the command prompt endpoint.
+func (c *PFSenseClientV2) RestoreConfigRevision(time int) error {
+	// The reload runs in the background since it can outlast the request.
+	command := fmt.Sprintf(`php -r 'require_once("config.inc"); exit(config_restore("/conf/backup/config-%d.xml"));' && `+
+		`(nohup /etc/rc.reload_all > /dev/null 2>&1 &)`, time)
+	_, err := c.runCommand(context.Background(), fmt.Sprintf("restoring configuration revision %d", time), command)
+	return err
+}
+
+// configAreaPattern matches the names of config.xml sections, which are
+// passed to the device's shell.
+var configAreaPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// GetConfig returns config.xml, or only its area section (e.g. "aliases" or
+// "filter") when area is not empty, as a backup downloaded from Diagnostics >
+// Backup & Restore would contain it.
+func (c *PFSenseClientV2) GetConfig(area string) (string, error) {
+	command := "cat /conf/config.xml"
+	if area != "" {
+		if !configAreaPattern.MatchString(area) {
+			return "", fmt.Errorf("invalid configuration area %q", area)
+		}
+		command = fmt.Sprintf(`php -r 'require_once("config.inc"); echo backup_config_section("%s");'`, area)
+	}
+	return c.runCommand(context.Background(), "retrieving configuration", command)
+}
+
+// configSnapshotDescription is the description of the configuration revision
+// recorded before the first change of a run.
+const configSnapshotDescription = "Snapshot before Terraform changes"
+
+// EnableConfigSnapshot makes the client record the configuration as a
+// revision before the first change it sends, so a run can be reverted with
+// RestoreConfigRevision.
+func (c *PFSenseClientV2) EnableConfigSnapshot() {
+	c.configSnapshot = true
+}
+
+// snapshotBeforeChange writes the unchanged configuration once, before the
+// first change of the run; every method that changes the configuration calls
+// it first. Writing it gives it the snapshot description, and the next change
+// moves it into the configuration history.
+func (c *PFSenseClientV2) snapshotBeforeChange() error {
+	if !c.configSnapshot {
+		return nil
+	}
+	c.snapshotOnce.Do(func() {
+		command := fmt.Sprintf(`php -r 'require_once("config.inc"); write_config("%s");'`, configSnapshotDescription)
+		_, c.snapshotErr = c.runCommand(context.Background(), "taking configuration snapshot", command)
+	})
+	return c.snapshotErr
+}
+
+// runCommand runs a shell command on the device and returns its output,
+// failing when it exits with a non-zero status.
+func (c *PFSenseClientV2) runCommand(ctx context.Context, action string, command string) (string, error) {
+	response, err := c.apiClient.PostDiagnosticsCommandPromptEndpointWithResponse(ctx, DiagnosticsCommandPrompt{Command: &command})
+	if err != nil {
+		return "", err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return "", responseError(action, response.StatusCode(), response.Body)
+	}
+	output := valueOrZero(response.JSON200.Data.Output)
+	if code := valueOrZero(response.JSON200.Data.ResultCode); code != 0 {
+		return "", fmt.Errorf("%s: command exited with status %d: %s", action, code, output)
+	}
+	return output, nil
+}
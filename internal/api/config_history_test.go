@@ -0,0 +1,28 @@
+package pfsense_rest_v2
+
+import (
+	"testing"
+)
+
+func TestGetConfig_InvalidArea(t *testing.T) {
+	client, err := NewPFSenseClientV2("https://192.168.1.1", &APIKeyAuth{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, area := range []string{"aliases; reboot", "../config", "Filter"} {
+		if _, err := client.GetConfig(area); err == nil {
+			t.Errorf("expected area %q to be refused", area)
+		}
+	}
+}
+
+func TestSnapshotBeforeChange_Disabled(t *testing.T) {
+	client, err := NewPFSenseClientV2("https://192.168.1.1", &APIKeyAuth{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Snapshots are opt-in, so nothing is sent to the device.
+	if err := client.snapshotBeforeChange(); err != nil {
+		t.Errorf("unexpected error with snapshots disabled: %v", err)
+	}
+}
@@ -0,0 +1,1040 @@
+package pfsense_rest_v2
+
+import (
+	"strings"
+)
+
+// The generated models mark nearly every field as optional, so every value we
+// read back from pfSense may be nil. These helpers keep that null handling in
+// one place rather than scattering dereferences across the client.
+
+// valueOr returns the value p points to, or fallback when p is nil.
+func valueOr[T any](p *T, fallback T) T {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
+
+// valueOrZero returns the value p points to, or the zero value of T when p is nil.
+func valueOrZero[T any](p *T) T {
+	var zero T
+	return valueOr(p, zero)
+}
+
+// pointerTo returns a pointer to a copy of v.
+func pointerTo[T any](v T) *T {
+	return &v
+}
+
+// enumString converts an optional generated enum to an optional string.
+func enumString[T ~string](p *T) *string {
+	if p == nil {
+		return nil
+	}
+	s := string(*p)
+	return &s
+}
+
+// stringEnum converts an optional string to an optional generated enum.
+func stringEnum[T ~string](p *string) *T {
+	if p == nil {
+		return nil
+	}
+	e := T(*p)
+	return &e
+}
+
+// nilIfEmpty returns p, or nil when p points to an empty string. pfSense
+// reports some unset references as empty strings.
+func nilIfEmpty(p *string) *string {
+	if p == nil || *p == "" {
+		return nil
+	}
+	return p
+}
+
+// enumStrings converts an optional slice of generated enums to a slice of
+// strings. The result is never nil.
+func enumStrings[T ~string](p *[]T) []string {
+	result := []string{}
+	for _, v := range sliceOrEmpty(p) {
+		result = append(result, string(v))
+	}
+	return result
+}
+
+// stringEnums converts a slice of strings to a slice of generated enums.
+func stringEnums[T ~string](values []string) []T {
+	result := []T{}
+	for _, v := range values {
+		result = append(result, T(v))
+	}
+	return result
+}
+
+// sliceOrEmpty returns the slice p points to, or an empty (non-nil) slice when p is nil.
+func sliceOrEmpty[T any](p *[]T) []T {
+	if p == nil {
+		return []T{}
+	}
+	return *p
+}
+
+// FirewallRuleFromAPI maps a generated FirewallRule to the domain type.
+func FirewallRuleFromAPI(r FirewallRule) *PFSenseFirewallRule {
+	return &PFSenseFirewallRule{
+		ID:               valueOrZero(r.Id),
+		Tracker:          valueOrZero(r.Tracker),
+		Type:             string(valueOrZero(r.Type)),
+		Interfaces:       sliceOrEmpty(r.Interface),
+		Disabled:         valueOrZero(r.Disabled),
+		AddressFamily:    string(valueOr(r.Ipprotocol, FirewallRuleIpprotocolInet)),
+		Log:              valueOrZero(r.Log),
+		Description:      valueOrZero(r.Descr),
+		Protocol:         enumString(r.Protocol),
+		Source:           valueOr(r.Source, "any"),
+		SourcePort:       r.SourcePort,
+		Destination:      valueOr(r.Destination, "any"),
+		DestinationPort:  r.DestinationPort,
+		Schedule:         nilIfEmpty(r.Sched),
+		InPipe:           nilIfEmpty(r.Dnpipe),
+		OutPipe:          nilIfEmpty(r.Pdnpipe),
+		DefaultQueue:     nilIfEmpty(r.Defaultqueue),
+		AckQueue:         nilIfEmpty(r.Ackqueue),
+		Gateway:          nilIfEmpty(r.Gateway),
+		ICMPTypes:        icmpTypesFromAPI(r.Icmptype),
+		StateType:        string(valueOr(r.Statetype, FirewallRuleStatetypeKeepState)),
+		TCPFlagsAny:      valueOrZero(r.TcpFlagsAny),
+		TCPFlagsOutOf:    enumStrings(r.TcpFlagsOutOf),
+		TCPFlagsSet:      enumStrings(r.TcpFlagsSet),
+		Floating:         valueOrZero(r.Floating),
+		Direction:        string(valueOr(r.Direction, FirewallRuleDirectionAny)),
+		Quick:            valueOrZero(r.Quick),
+		AssociatedRuleID: nilIfEmpty(r.AssociatedRuleId),
+	}
+}
+
+// icmpTypesFromAPI reads the ICMP types of a rule. pfSense reports a rule
+// matching every ICMP type as the single type "any", which the domain type
+// represents as an empty slice.
+func icmpTypesFromAPI(p *[]FirewallRuleIcmptype) []string {
+	icmpTypes := enumStrings(p)
+	if len(icmpTypes) == 1 && icmpTypes[0] == "any" {
+		return []string{}
+	}
+	return icmpTypes
+}
+
+// ToAPI maps the domain type back to a generated FirewallRule suitable for a
+// request body. Optional domain fields that are nil are left unset so pfSense
+// applies its own defaults.
+func (r *PFSenseFirewallRule) ToAPI() FirewallRule {
+	body := FirewallRule{
+		Type:            pointerTo(FirewallRuleType(r.Type)),
+		Interface:       pointerTo(r.Interfaces),
+		Disabled:        pointerTo(r.Disabled),
+		Ipprotocol:      pointerTo(FirewallRuleIpprotocol(r.AddressFamily)),
+		Log:             pointerTo(r.Log),
+		Descr:           pointerTo(r.Description),
+		Protocol:        stringEnum[FirewallRuleProtocol](r.Protocol),
+		Source:          pointerTo(r.Source),
+		SourcePort:      r.SourcePort,
+		Destination:     pointerTo(r.Destination),
+		DestinationPort: r.DestinationPort,
+		// Empty names detach the schedule, limiters, queues and gateway;
+		// omitting them would leave them in place.
+		Sched:         pointerTo(valueOrZero(r.Schedule)),
+		Dnpipe:        pointerTo(valueOrZero(r.InPipe)),
+		Pdnpipe:       pointerTo(valueOrZero(r.OutPipe)),
+		Defaultqueue:  pointerTo(valueOrZero(r.DefaultQueue)),
+		Ackqueue:      pointerTo(valueOrZero(r.AckQueue)),
+		Gateway:       pointerTo(valueOrZero(r.Gateway)),
+		Statetype:     pointerTo(FirewallRuleStatetype(r.StateType)),
+		TcpFlagsAny:   pointerTo(r.TCPFlagsAny),
+		TcpFlagsOutOf: pointerTo(stringEnums[FirewallRuleTcpFlagsOutOf](r.TCPFlagsOutOf)),
+		TcpFlagsSet:   pointerTo(stringEnums[FirewallRuleTcpFlagsSet](r.TCPFlagsSet)),
+		Floating:      pointerTo(r.Floating),
+	}
+	// pfSense only accepts ICMP types on ICMP rules, where an empty list
+	// means any type.
+	if valueOrZero(r.Protocol) == string(FirewallRuleProtocolIcmp) {
+		icmpTypes := r.ICMPTypes
+		if len(icmpTypes) == 0 {
+			icmpTypes = []string{"any"}
+		}
+		body.Icmptype = pointerTo(stringEnums[FirewallRuleIcmptype](icmpTypes))
+	}
+	if r.Floating {
+		body.Direction = pointerTo(FirewallRuleDirection(r.Direction))
+		body.Quick = pointerTo(r.Quick)
+	}
+	return body
+}
+
+// FirewallStateFromAPI maps a generated FirewallState to the domain type.
+func FirewallStateFromAPI(s FirewallState) *PFSenseFirewallState {
+	return &PFSenseFirewallState{
+		Interface:   valueOrZero(s.Interface),
+		Protocol:    valueOrZero(s.Protocol),
+		Direction:   valueOrZero(s.Direction),
+		Source:      valueOrZero(s.Source),
+		Destination: valueOrZero(s.Destination),
+		State:       valueOrZero(s.State),
+		Age:         valueOrZero(s.Age),
+		ExpiresIn:   valueOrZero(s.ExpiresIn),
+		PacketsIn:   valueOrZero(s.PacketsIn),
+		PacketsOut:  valueOrZero(s.PacketsOut),
+		BytesIn:     valueOrZero(s.BytesIn),
+		BytesOut:    valueOrZero(s.BytesOut),
+	}
+}
+
+// DNSResolverHostOverrideFromAPI maps a generated DNSResolverHostOverride to the domain type.
+func DNSResolverHostOverrideFromAPI(o DNSResolverHostOverride) *PFSenseDNSResolverHostOverride {
+	override := &PFSenseDNSResolverHostOverride{
+		ID:          valueOrZero(o.Id),
+		Host:        valueOrZero(o.Host),
+		Domain:      valueOrZero(o.Domain),
+		IPs:         sliceOrEmpty(o.Ip),
+		Description: valueOrZero(o.Descr),
+		Aliases:     []PFSenseDNSResolverHostOverrideAlias{},
+	}
+	for _, a := range sliceOrEmpty(o.Aliases) {
+		override.Aliases = append(override.Aliases, PFSenseDNSResolverHostOverrideAlias{
+			Host:        valueOrZero(a.Host),
+			Domain:      valueOrZero(a.Domain),
+			Description: valueOrZero(a.Descr),
+		})
+	}
+	return override
+}
+
+// ToAPI maps the domain type back to a generated DNSResolverHostOverride suitable for a request body.
+func (o *PFSenseDNSResolverHostOverride) ToAPI() DNSResolverHostOverride {
+	aliases := []DNSResolverHostOverrideAlias{}
+	for _, a := range o.Aliases {
+		aliases = append(aliases, DNSResolverHostOverrideAlias{
+			Host:   pointerTo(a.Host),
+			Domain: pointerTo(a.Domain),
+			Descr:  pointerTo(a.Description),
+		})
+	}
+	return DNSResolverHostOverride{
+		Host:    pointerTo(o.Host),
+		Domain:  pointerTo(o.Domain),
+		Ip:      pointerTo(o.IPs),
+		Descr:   pointerTo(o.Description),
+		Aliases: &aliases,
+	}
+}
+
+// DNSResolverDomainOverrideFromAPI maps a generated DNSResolverDomainOverride to the domain type.
+func DNSResolverDomainOverrideFromAPI(o DNSResolverDomainOverride) *PFSenseDNSResolverDomainOverride {
+	return &PFSenseDNSResolverDomainOverride{
+		ID:                 valueOrZero(o.Id),
+		Domain:             valueOrZero(o.Domain),
+		Server:             valueOrZero(o.Ip),
+		Description:        valueOrZero(o.Descr),
+		ForwardTLSUpstream: valueOrZero(o.ForwardTlsUpstream),
+		TLSHostname:        valueOrZero(o.TlsHostname),
+	}
+}
+
+// ToAPI maps the domain type back to a generated DNSResolverDomainOverride suitable for a request body.
+func (o *PFSenseDNSResolverDomainOverride) ToAPI() DNSResolverDomainOverride {
+	return DNSResolverDomainOverride{
+		Domain:             pointerTo(o.Domain),
+		Ip:                 pointerTo(o.Server),
+		Descr:              pointerTo(o.Description),
+		ForwardTlsUpstream: pointerTo(o.ForwardTLSUpstream),
+		TlsHostname:        pointerTo(o.TLSHostname),
+	}
+}
+
+// DNSResolverAccessListFromAPI maps a generated DNSResolverAccessList to the domain type.
+func DNSResolverAccessListFromAPI(l DNSResolverAccessList) *PFSenseDNSResolverAccessList {
+	list := &PFSenseDNSResolverAccessList{
+		ID:          valueOrZero(l.Id),
+		Name:        valueOrZero(l.Name),
+		Action:      string(valueOr(l.Action, DNSResolverAccessListActionAllow)),
+		Description: valueOrZero(l.Description),
+		Networks:    []PFSenseDNSResolverAccessListNetwork{},
+	}
+	for _, n := range sliceOrEmpty(l.Networks) {
+		list.Networks = append(list.Networks, PFSenseDNSResolverAccessListNetwork{
+			Network:     valueOrZero(n.Network),
+			Mask:        valueOrZero(n.Mask),
+			Description: valueOrZero(n.Description),
+		})
+	}
+	return list
+}
+
+// ToAPI maps the domain type back to a generated DNSResolverAccessList suitable for a request body.
+func (l *PFSenseDNSResolverAccessList) ToAPI() DNSResolverAccessList {
+	networks := []DNSResolverAccessListNetwork{}
+	for _, n := range l.Networks {
+		networks = append(networks, DNSResolverAccessListNetwork{
+			Network:     pointerTo(n.Network),
+			Mask:        pointerTo(n.Mask),
+			Description: pointerTo(n.Description),
+		})
+	}
+	return DNSResolverAccessList{
+		Name:        pointerTo(l.Name),
+		Action:      pointerTo(DNSResolverAccessListAction(l.Action)),
+		Description: pointerTo(l.Description),
+		Networks:    &networks,
+	}
+}
+
+// DNSResolverSettingsFromAPI maps generated DNSResolverSettings to the domain type.
+func DNSResolverSettingsFromAPI(s DNSResolverSettings) *PFSenseDNSResolverSettings {
+	return &PFSenseDNSResolverSettings{
+		Enabled:            valueOrZero(s.Enable),
+		Port:               valueOr(s.Port, "53"),
+		ActiveInterfaces:   valueOr(s.ActiveInterface, []string{"all"}),
+		OutgoingInterfaces: valueOr(s.OutgoingInterface, []string{"all"}),
+		StrictOutgoing:     valueOrZero(s.Strictout),
+		DNSSEC:             valueOrZero(s.Dnssec),
+		Forwarding:         valueOrZero(s.Forwarding),
+		ForwardTLSUpstream: valueOrZero(s.ForwardTlsUpstream),
+		RegisterDHCP:       valueOrZero(s.Regdhcp),
+		RegisterDHCPStatic: valueOrZero(s.Regdhcpstatic),
+		RegisterOpenVPN:    valueOrZero(s.Regovpnclients),
+		CustomOptions:      valueOrZero(s.CustomOptions),
+		Python:             valueOrZero(s.Python),
+		PythonOrder:        string(valueOr(s.PythonOrder, DNSResolverSettingsPythonOrderPreValidator)),
+		PythonScript:       valueOrZero(s.PythonScript),
+	}
+}
+
+// ToAPI maps the domain type back to generated DNSResolverSettings suitable for a request body.
+func (s *PFSenseDNSResolverSettings) ToAPI() DNSResolverSettings {
+	return DNSResolverSettings{
+		Enable:             pointerTo(s.Enabled),
+		Port:               pointerTo(s.Port),
+		ActiveInterface:    pointerTo(s.ActiveInterfaces),
+		OutgoingInterface:  pointerTo(s.OutgoingInterfaces),
+		Strictout:          pointerTo(s.StrictOutgoing),
+		Dnssec:             pointerTo(s.DNSSEC),
+		Forwarding:         pointerTo(s.Forwarding),
+		ForwardTlsUpstream: pointerTo(s.ForwardTLSUpstream),
+		Regdhcp:            pointerTo(s.RegisterDHCP),
+		Regdhcpstatic:      pointerTo(s.RegisterDHCPStatic),
+		Regovpnclients:     pointerTo(s.RegisterOpenVPN),
+		CustomOptions:      pointerTo(s.CustomOptions),
+		Python:             pointerTo(s.Python),
+		PythonOrder:        pointerTo(DNSResolverSettingsPythonOrder(s.PythonOrder)),
+		PythonScript:       pointerTo(s.PythonScript),
+	}
+}
+
+// UserFromAPI maps a generated User to the domain type. The password is
+// never read back.
+func UserFromAPI(u User) *PFSenseUser {
+	user := &PFSenseUser{
+		ID:             valueOrZero(u.Id),
+		Name:           valueOrZero(u.Name),
+		Privileges:     sliceOrEmpty(u.Priv),
+		Disabled:       valueOrZero(u.Disabled),
+		Description:    valueOrZero(u.Descr),
+		Expires:        valueOrZero(u.Expires),
+		AuthorizedKeys: []string{},
+	}
+	for _, key := range strings.Split(valueOrZero(u.Authorizedkeys), "\n") {
+		if key = strings.TrimSpace(key); key != "" {
+			user.AuthorizedKeys = append(user.AuthorizedKeys, key)
+		}
+	}
+	return user
+}
+
+// ToAPI maps the domain type back to a generated User suitable for a request
+// body. An empty password is left out so the current one is kept.
+func (u *PFSenseUser) ToAPI() User {
+	return User{
+		Name:           pointerTo(u.Name),
+		Password:       nilIfEmpty(pointerTo(u.Password)),
+		Priv:           pointerTo(u.Privileges),
+		Disabled:       pointerTo(u.Disabled),
+		Descr:          pointerTo(u.Description),
+		Expires:        pointerTo(u.Expires),
+		Authorizedkeys: pointerTo(strings.Join(u.AuthorizedKeys, "\n")),
+	}
+}
+
+// UserGroupFromAPI maps a generated UserGroup to the domain type.
+func UserGroupFromAPI(g UserGroup) *PFSenseUserGroup {
+	return &PFSenseUserGroup{
+		ID:          valueOrZero(g.Id),
+		Name:        valueOrZero(g.Name),
+		Description: valueOrZero(g.Description),
+		Scope:       string(valueOr(g.Scope, UserGroupScopeLocal)),
+		Members:     sliceOrEmpty(g.Member),
+		Privileges:  sliceOrEmpty(g.Priv),
+	}
+}
+
+// ToAPI maps the domain type back to a generated UserGroup suitable for a request body.
+func (g *PFSenseUserGroup) ToAPI() UserGroup {
+	return UserGroup{
+		Name:        pointerTo(g.Name),
+		Description: pointerTo(g.Description),
+		Scope:       pointerTo(UserGroupScope(g.Scope)),
+		Member:      pointerTo(g.Members),
+		Priv:        pointerTo(g.Privileges),
+	}
+}
+
+// CertificateAuthorityFromAPI maps a generated SystemCertificateAuthority to
+// the domain type. The private key is never read back.
+func CertificateAuthorityFromAPI(ca SystemCertificateAuthority) *PFSenseCertificateAuthority {
+	return &PFSenseCertificateAuthority{
+		ID:           valueOrZero(ca.Id),
+		RefID:        valueOrZero(ca.Refid),
+		Description:  valueOrZero(ca.Descr),
+		Trust:        valueOrZero(ca.Trust),
+		RandomSerial: valueOrZero(ca.Randomserial),
+		Certificate:  valueOrZero(ca.Crt),
+		CARefID:      valueOrZero(ca.Caref),
+	}
+}
+
+// ToAPI maps the domain type back to a generated SystemCertificateAuthority
+// suitable for a request body. An empty private key is left out so the
+// current one is kept.
+func (ca *PFSenseCertificateAuthority) ToAPI() SystemCertificateAuthority {
+	return SystemCertificateAuthority{
+		Descr:        pointerTo(ca.Description),
+		Trust:        pointerTo(ca.Trust),
+		Randomserial: pointerTo(ca.RandomSerial),
+		Crt:          pointerTo(ca.Certificate),
+		Prv:          nilIfEmpty(pointerTo(ca.PrivateKey)),
+	}
+}
+
+// GenerateAPI builds the request body generating the CA on the device.
+func (ca *PFSenseCertificateAuthority) GenerateAPI(r *PFSenseCertificateRequest) SystemCertificateAuthorityGenerate {
+	return SystemCertificateAuthorityGenerate{
+		Descr:                pointerTo(ca.Description),
+		Trust:                pointerTo(ca.Trust),
+		Randomserial:         pointerTo(ca.RandomSerial),
+		IsIntermediate:       pointerTo(r.Intermediate),
+		Caref:                nilIfEmpty(pointerTo(ca.CARefID)),
+		Keytype:              pointerTo(SystemCertificateAuthorityGenerateKeytype(r.KeyType)),
+		Keylen:               pointerTo(r.KeyLength),
+		Ecname:               pointerTo(r.Curve),
+		DigestAlg:            pointerTo(r.DigestAlgorithm),
+		Lifetime:             pointerTo(r.LifetimeDays),
+		DnCommonname:         pointerTo(r.Subject.CommonName),
+		DnCountry:            nilIfEmpty(pointerTo(r.Subject.Country)),
+		DnState:              nilIfEmpty(pointerTo(r.Subject.State)),
+		DnCity:               nilIfEmpty(pointerTo(r.Subject.City)),
+		DnOrganization:       nilIfEmpty(pointerTo(r.Subject.Organization)),
+		DnOrganizationalunit: nilIfEmpty(pointerTo(r.Subject.OrganizationalUnit)),
+	}
+}
+
+// CertificateFromAPI maps a generated SystemCertificate to the domain type.
+// The private key is never read back.
+func CertificateFromAPI(c SystemCertificate) *PFSenseCertificate {
+	return &PFSenseCertificate{
+		ID:          valueOrZero(c.Id),
+		RefID:       valueOrZero(c.Refid),
+		Description: valueOrZero(c.Descr),
+		Type:        string(valueOr(c.Type, SystemCertificateTypeServer)),
+		CSR:         valueOrZero(c.Csr),
+		Certificate: valueOrZero(c.Crt),
+		CARefID:     valueOrZero(c.Caref),
+	}
+}
+
+// ToAPI maps the domain type back to a generated SystemCertificate suitable
+// for a request body. An empty private key is left out so the current one is
+// kept, and an empty certificate so a pending signing request stays pending.
+func (c *PFSenseCertificate) ToAPI() SystemCertificate {
+	return SystemCertificate{
+		Descr: pointerTo(c.Description),
+		Type:  pointerTo(SystemCertificateType(c.Type)),
+		Crt:   nilIfEmpty(pointerTo(c.Certificate)),
+		Prv:   nilIfEmpty(pointerTo(c.PrivateKey)),
+	}
+}
+
+// GenerateAPI builds the request body generating the certificate on the device.
+func (c *PFSenseCertificate) GenerateAPI(r *PFSenseCertificateRequest) SystemCertificateGenerate {
+	return SystemCertificateGenerate{
+		Descr:                pointerTo(c.Description),
+		Caref:                pointerTo(c.CARefID),
+		Type:                 pointerTo(SystemCertificateType(c.Type)),
+		DnsSans:              pointerTo(r.DNSNames),
+		IpSans:               pointerTo(r.IPAddresses),
+		Keytype:              pointerTo(SystemCertificateGenerateKeytype(r.KeyType)),
+		Keylen:               pointerTo(r.KeyLength),
+		Ecname:               pointerTo(r.Curve),
+		DigestAlg:            pointerTo(r.DigestAlgorithm),
+		Lifetime:             pointerTo(r.LifetimeDays),
+		DnCommonname:         pointerTo(r.Subject.CommonName),
+		DnCountry:            nilIfEmpty(pointerTo(r.Subject.Country)),
+		DnState:              nilIfEmpty(pointerTo(r.Subject.State)),
+		DnCity:               nilIfEmpty(pointerTo(r.Subject.City)),
+		DnOrganization:       nilIfEmpty(pointerTo(r.Subject.Organization)),
+		DnOrganizationalunit: nilIfEmpty(pointerTo(r.Subject.OrganizationalUnit)),
+	}
+}
+
+// SigningRequestAPI builds the request body creating a signing request for
+// the certificate on the device.
+func (c *PFSenseCertificate) SigningRequestAPI(r *PFSenseCertificateRequest) SystemCertificateSigningRequest {
+	return SystemCertificateSigningRequest{
+		Descr:                pointerTo(c.Description),
+		Type:                 pointerTo(SystemCertificateType(c.Type)),
+		DnsSans:              pointerTo(r.DNSNames),
+		IpSans:               pointerTo(r.IPAddresses),
+		Keytype:              pointerTo(SystemCertificateSigningRequestKeytype(r.KeyType)),
+		Keylen:               pointerTo(r.KeyLength),
+		Ecname:               pointerTo(r.Curve),
+		DigestAlg:            pointerTo(r.DigestAlgorithm),
+		Lifetime:             pointerTo(r.LifetimeDays),
+		DnCommonname:         pointerTo(r.Subject.CommonName),
+		DnCountry:            nilIfEmpty(pointerTo(r.Subject.Country)),
+		DnState:              nilIfEmpty(pointerTo(r.Subject.State)),
+		DnCity:               nilIfEmpty(pointerTo(r.Subject.City)),
+		DnOrganization:       nilIfEmpty(pointerTo(r.Subject.Organization)),
+		DnOrganizationalunit: nilIfEmpty(pointerTo(r.Subject.OrganizationalUnit)),
+	}
+}
+
+// GatewayFromAPI maps a generated RoutingGatewayStatus to the domain type.
+func GatewayFromAPI(g RoutingGatewayStatus) *PFSenseGateway {
+	return &PFSenseGateway{
+		Name:      valueOrZero(g.Name),
+		SourceIP:  valueOrZero(g.Srcip),
+		MonitorIP: valueOrZero(g.Monitorip),
+		Status:    valueOrZero(g.Status),
+	}
+}
+
+// GatewayGroupFromAPI maps a generated RoutingGatewayGroup to the domain type.
+func GatewayGroupFromAPI(g RoutingGatewayGroup) *PFSenseGatewayGroup {
+	return &PFSenseGatewayGroup{
+		ID:          valueOrZero(g.Id),
+		Name:        valueOrZero(g.Name),
+		Trigger:     string(valueOrZero(g.Trigger)),
+		Description: valueOrZero(g.Descr),
+	}
+}
+
+// BaseConfigFromAPI maps a generated SystemHostname to the domain type.
+func BaseConfigFromAPI(h SystemHostname) *PFSenseBaseConfig {
+	return &PFSenseBaseConfig{
+		Hostname: valueOrZero(h.Hostname),
+		Domain:   valueOrZero(h.Domain),
+	}
+}
+
+// ToAPI maps the domain type back to a generated SystemHostname suitable for a request body.
+func (c *PFSenseBaseConfig) ToAPI() SystemHostname {
+	return SystemHostname{
+		Hostname: pointerTo(c.Hostname),
+		Domain:   pointerTo(c.Domain),
+	}
+}
+
+// SystemDNSFromAPI maps a generated SystemDNS to the domain type. pfSense
+// keeps one gateway per server, "none" when the server is reached through the
+// routing table.
+func SystemDNSFromAPI(d SystemDNS) *PFSenseSystemDNS {
+	dns := &PFSenseSystemDNS{
+		Servers:       []PFSenseSystemDNSServer{},
+		AllowOverride: valueOrZero(d.Dnsallowoverride),
+	}
+	gateways := sliceOrEmpty(d.Dnsgw)
+	for i, address := range sliceOrEmpty(d.Dnsserver) {
+		server := PFSenseSystemDNSServer{Address: address}
+		if i < len(gateways) && gateways[i] != "none" && gateways[i] != "" {
+			server.Gateway = pointerTo(gateways[i])
+		}
+		dns.Servers = append(dns.Servers, server)
+	}
+	return dns
+}
+
+// ToAPI maps the domain type back to a generated SystemDNS suitable for a request body.
+func (d *PFSenseSystemDNS) ToAPI() SystemDNS {
+	servers := []string{}
+	gateways := []string{}
+	for _, server := range d.Servers {
+		servers = append(servers, server.Address)
+		gateways = append(gateways, valueOr(server.Gateway, "none"))
+	}
+	return SystemDNS{
+		Dnsallowoverride: pointerTo(d.AllowOverride),
+		Dnsserver:        &servers,
+		Dnsgw:            &gateways,
+	}
+}
+
+// WebGUISettingsFromAPI maps generated SystemWebGUISettings to the domain
+// type. pfSense stores the anti-lockout rule, the DNS rebind check and HSTS
+// as flags disabling them.
+func WebGUISettingsFromAPI(w SystemWebGUISettings) *PFSenseWebGUISettings {
+	return &PFSenseWebGUISettings{
+		Protocol:            string(valueOr(w.Protocol, SystemWebGUISettingsProtocolHttps)),
+		Port:                valueOrZero(w.Port),
+		SSLCertificateRefID: valueOrZero(w.Sslcertref),
+		AntiLockout:         !valueOrZero(w.Noantilockout),
+		DNSRebindCheck:      !valueOrZero(w.Nodnsrebindcheck),
+		HSTS:                !valueOrZero(w.Disablehsts),
+	}
+}
+
+// ToAPI maps the domain type back to generated SystemWebGUISettings suitable for a request body.
+func (w *PFSenseWebGUISettings) ToAPI() SystemWebGUISettings {
+	return SystemWebGUISettings{
+		Protocol:         pointerTo(SystemWebGUISettingsProtocol(w.Protocol)),
+		Port:             pointerTo(w.Port),
+		Sslcertref:       pointerTo(w.SSLCertificateRefID),
+		Noantilockout:    pointerTo(!w.AntiLockout),
+		Nodnsrebindcheck: pointerTo(!w.DNSRebindCheck),
+		Disablehsts:      pointerTo(!w.HSTS),
+	}
+}
+
+// SSHSettingsFromAPI maps generated ServicesSSH settings to the domain type.
+func SSHSettingsFromAPI(s ServicesSSH) *PFSenseSSHSettings {
+	return &PFSenseSSHSettings{
+		Enabled:         valueOrZero(s.Enable),
+		Port:            valueOr(s.Port, "22"),
+		KeyOnly:         string(valueOr(s.Sshdkeyonly, ServicesSSHSshdkeyonlyDisabled)),
+		AgentForwarding: valueOrZero(s.Sshdagentforwarding),
+	}
+}
+
+// ToAPI maps the domain type back to generated ServicesSSH settings suitable for a request body.
+func (s *PFSenseSSHSettings) ToAPI() ServicesSSH {
+	return ServicesSSH{
+		Enable:              pointerTo(s.Enabled),
+		Port:                pointerTo(s.Port),
+		Sshdkeyonly:         pointerTo(ServicesSSHSshdkeyonly(s.KeyOnly)),
+		Sshdagentforwarding: pointerTo(s.AgentForwarding),
+	}
+}
+
+// RESTAPISettingsFromAPI maps generated SystemRESTAPISettings to the domain
+// type. The HA sync password is never read back.
+func RESTAPISettingsFromAPI(s SystemRESTAPISettings) *PFSenseRESTAPISettings {
+	return &PFSenseRESTAPISettings{
+		AuthMethods:                enumStrings(s.AuthMethods),
+		AllowedInterfaces:          sliceOrEmpty(s.AllowedInterfaces),
+		ReadOnly:                   valueOrZero(s.ReadOnly),
+		JWTExpiry:                  valueOr(s.JwtExp, 3600),
+		LoginProtection:            valueOrZero(s.LoginProtection),
+		HASync:                     valueOrZero(s.HaSync),
+		HASyncValidateCertificates: valueOrZero(s.HaSyncValidateCerts),
+		HASyncHosts:                sliceOrEmpty(s.HaSyncHosts),
+		HASyncUsername:             valueOrZero(s.HaSyncUsername),
+	}
+}
+
+// ToAPI maps the domain type back to generated SystemRESTAPISettings suitable
+// for a request body. An empty HA sync password is left out so the current
+// one is kept.
+func (s *PFSenseRESTAPISettings) ToAPI() SystemRESTAPISettings {
+	settings := SystemRESTAPISettings{
+		AuthMethods:         pointerTo(stringEnums[SystemRESTAPISettingsAuthMethods](s.AuthMethods)),
+		AllowedInterfaces:   pointerTo(s.AllowedInterfaces),
+		ReadOnly:            pointerTo(s.ReadOnly),
+		JwtExp:              pointerTo(s.JWTExpiry),
+		LoginProtection:     pointerTo(s.LoginProtection),
+		HaSync:              pointerTo(s.HASync),
+		HaSyncValidateCerts: pointerTo(s.HASyncValidateCertificates),
+		HaSyncHosts:         pointerTo(s.HASyncHosts),
+		HaSyncUsername:      pointerTo(s.HASyncUsername),
+	}
+	if s.HASyncPassword != "" {
+		settings.HaSyncPassword = pointerTo(s.HASyncPassword)
+	}
+	return settings
+}
+
+// ConfigRevisionFromAPI maps a generated DiagnosticsConfigHistoryRevision to the domain type.
+func ConfigRevisionFromAPI(r DiagnosticsConfigHistoryRevision) *PFSenseConfigRevision {
+	return &PFSenseConfigRevision{
+		ID:          valueOrZero(r.Id),
+		Time:        valueOrZero(r.Time),
+		Description: valueOrZero(r.Description),
+		Version:     valueOrZero(r.Version),
+		Size:        valueOrZero(r.Filesize),
+	}
+}
+
+// SystemTunableFromAPI maps a generated SystemTunable to the domain type.
+func SystemTunableFromAPI(t SystemTunable) *PFSenseSystemTunable {
+	return &PFSenseSystemTunable{
+		ID:          valueOrZero(t.Id),
+		Name:        valueOrZero(t.Tunable),
+		Value:       valueOrZero(t.Value),
+		Description: valueOrZero(t.Descr),
+	}
+}
+
+// ToAPI maps the domain type back to a generated SystemTunable suitable for a request body.
+func (t *PFSenseSystemTunable) ToAPI() SystemTunable {
+	return SystemTunable{
+		Tunable: pointerTo(t.Name),
+		Value:   pointerTo(t.Value),
+		Descr:   pointerTo(t.Description),
+	}
+}
+
+// InstalledPackageFromAPI maps a generated SystemPackage to the domain type.
+func InstalledPackageFromAPI(p SystemPackage) *PFSenseInstalledPackage {
+	return &PFSenseInstalledPackage{
+		ID:               valueOrZero(p.Id),
+		Name:             valueOrZero(p.Name),
+		ShortName:        valueOrZero(p.Shortname),
+		Description:      valueOrZero(p.Descr),
+		InstalledVersion: valueOrZero(p.InstalledVersion),
+		LatestVersion:    valueOrZero(p.LatestVersion),
+		UpdateAvailable:  valueOrZero(p.UpdateAvailable),
+	}
+}
+
+// AvailablePackageFromAPI maps a generated SystemPackageAvailable to the domain type.
+func AvailablePackageFromAPI(p SystemPackageAvailable) *PFSenseAvailablePackage {
+	return &PFSenseAvailablePackage{
+		Name:         valueOrZero(p.Name),
+		ShortName:    valueOrZero(p.Shortname),
+		Description:  valueOrZero(p.Descr),
+		Version:      valueOrZero(p.Version),
+		Installed:    valueOrZero(p.Installed),
+		Dependencies: sliceOrEmpty(p.Deps),
+	}
+}
+
+// FirewallAliasFromAPI maps a generated FirewallAlias to the domain type.
+func FirewallAliasFromAPI(a FirewallAlias) *PFSenseFirewallAlias {
+	return &PFSenseFirewallAlias{
+		ID:          valueOrZero(a.Id),
+		Name:        valueOrZero(a.Name),
+		Type:        string(valueOrZero(a.Type)),
+		Description: valueOrZero(a.Descr),
+		Addresses:   sliceOrEmpty(a.Address),
+		Details:     sliceOrEmpty(a.Detail),
+	}
+}
+
+// ToAPI maps the domain type back to a generated FirewallAlias suitable for a request body.
+func (a *PFSenseFirewallAlias) ToAPI() FirewallAlias {
+	return FirewallAlias{
+		Name:    pointerTo(a.Name),
+		Type:    pointerTo(FirewallAliasType(a.Type)),
+		Descr:   pointerTo(a.Description),
+		Address: pointerTo(a.Addresses),
+		Detail:  pointerTo(a.Details),
+	}
+}
+
+// NATPortForwardFromAPI maps a generated PortForward to the domain type.
+func NATPortForwardFromAPI(p PortForward) *PFSenseNATPortForward {
+	return &PFSenseNATPortForward{
+		ID:               valueOrZero(p.Id),
+		Interface:        valueOrZero(p.Interface),
+		AddressFamily:    string(valueOr(p.Ipprotocol, PortForwardIpprotocolInet)),
+		Protocol:         string(valueOrZero(p.Protocol)),
+		Source:           valueOr(p.Source, "any"),
+		SourcePort:       p.SourcePort,
+		Destination:      valueOr(p.Destination, "any"),
+		DestinationPort:  p.DestinationPort,
+		Target:           valueOrZero(p.Target),
+		LocalPort:        p.LocalPort,
+		Disabled:         valueOrZero(p.Disabled),
+		NoRDR:            valueOrZero(p.Nordr),
+		NoSync:           valueOrZero(p.Nosync),
+		Description:      valueOrZero(p.Descr),
+		NATReflection:    enumString(p.Natreflection),
+		AssociatedRuleID: p.AssociatedRuleId,
+	}
+}
+
+// ToAPI maps the domain type back to a generated PortForward suitable for a request body.
+func (p *PFSenseNATPortForward) ToAPI() PortForward {
+	return PortForward{
+		Interface:        pointerTo(p.Interface),
+		Ipprotocol:       pointerTo(PortForwardIpprotocol(p.AddressFamily)),
+		Protocol:         pointerTo(PortForwardProtocol(p.Protocol)),
+		Source:           pointerTo(p.Source),
+		SourcePort:       p.SourcePort,
+		Destination:      pointerTo(p.Destination),
+		DestinationPort:  p.DestinationPort,
+		Target:           pointerTo(p.Target),
+		LocalPort:        p.LocalPort,
+		Disabled:         pointerTo(p.Disabled),
+		Nordr:            pointerTo(p.NoRDR),
+		Nosync:           pointerTo(p.NoSync),
+		Descr:            pointerTo(p.Description),
+		Natreflection:    stringEnum[PortForwardNatreflection](p.NATReflection),
+		AssociatedRuleId: p.AssociatedRuleID,
+	}
+}
+
+// DHCPStaticMappingFromAPI maps a generated DHCPServerStaticMapping to the domain type.
+func DHCPStaticMappingFromAPI(m DHCPServerStaticMapping) *PFSenseDHCPStaticMapping {
+	return &PFSenseDHCPStaticMapping{
+		ID:                  valueOrZero(m.Id),
+		Interface:           valueOrZero(m.ParentId),
+		MACAddress:          valueOrZero(m.Mac),
+		IPAddress:           m.Ipaddr,
+		ClientID:            m.Cid,
+		Hostname:            m.Hostname,
+		Domain:              m.Domain,
+		Description:         valueOrZero(m.Descr),
+		ARPTableStaticEntry: valueOrZero(m.ArpTableStaticEntry),
+	}
+}
+
+// ToAPI maps the domain type back to a generated DHCPServerStaticMapping suitable for a request body.
+func (m *PFSenseDHCPStaticMapping) ToAPI() DHCPServerStaticMapping {
+	return DHCPServerStaticMapping{
+		ParentId:            pointerTo(m.Interface),
+		Mac:                 pointerTo(m.MACAddress),
+		Ipaddr:              m.IPAddress,
+		Cid:                 m.ClientID,
+		Hostname:            m.Hostname,
+		Domain:              m.Domain,
+		Descr:               pointerTo(m.Description),
+		ArpTableStaticEntry: pointerTo(m.ARPTableStaticEntry),
+	}
+}
+
+// InterfaceFromAPI maps a generated NetworkInterface to the domain type.
+func InterfaceFromAPI(i NetworkInterface) *PFSenseInterface {
+	return &PFSenseInterface{
+		ID:           valueOrZero(i.Id),
+		Port:         valueOrZero(i.If),
+		Enabled:      valueOrZero(i.Enable),
+		Description:  valueOrZero(i.Descr),
+		SpoofMAC:     i.Spoofmac,
+		MTU:          i.Mtu,
+		BlockPrivate: valueOrZero(i.Blockpriv),
+		BlockBogons:  valueOrZero(i.Blockbogons),
+		IPv4Type:     string(valueOr(i.Typev4, NetworkInterfaceTypev4None)),
+		IPv4Address:  i.Ipaddr,
+		IPv4Subnet:   i.Subnet,
+		IPv4Gateway:  i.Gateway,
+		IPv6Type:     string(valueOr(i.Typev6, NetworkInterfaceTypev6None)),
+		IPv6Address:  i.Ipaddrv6,
+		IPv6Subnet:   i.Subnetv6,
+		IPv6Gateway:  i.Gatewayv6,
+	}
+}
+
+// ToAPI maps the domain type back to a generated NetworkInterface suitable for a request body.
+func (i *PFSenseInterface) ToAPI() NetworkInterface {
+	return NetworkInterface{
+		If:          pointerTo(i.Port),
+		Enable:      pointerTo(i.Enabled),
+		Descr:       pointerTo(i.Description),
+		Spoofmac:    i.SpoofMAC,
+		Mtu:         i.MTU,
+		Blockpriv:   pointerTo(i.BlockPrivate),
+		Blockbogons: pointerTo(i.BlockBogons),
+		Typev4:      pointerTo(NetworkInterfaceTypev4(i.IPv4Type)),
+		Ipaddr:      i.IPv4Address,
+		Subnet:      i.IPv4Subnet,
+		Gateway:     i.IPv4Gateway,
+		Typev6:      pointerTo(NetworkInterfaceTypev6(i.IPv6Type)),
+		Ipaddrv6:    i.IPv6Address,
+		Subnetv6:    i.IPv6Subnet,
+		Gatewayv6:   i.IPv6Gateway,
+	}
+}
+
+// FirewallScheduleFromAPI maps a generated FirewallSchedule to the domain type.
+func FirewallScheduleFromAPI(s FirewallSchedule) *PFSenseFirewallSchedule {
+	schedule := &PFSenseFirewallSchedule{
+		ID:          valueOrZero(s.Id),
+		Name:        valueOrZero(s.Name),
+		Description: valueOrZero(s.Descr),
+		TimeRanges:  []PFSenseFirewallScheduleTimeRange{},
+	}
+	for _, r := range sliceOrEmpty(s.Timerange) {
+		schedule.TimeRanges = append(schedule.TimeRanges, PFSenseFirewallScheduleTimeRange{
+			Weekdays:    sliceOrEmpty(r.Position),
+			Months:      sliceOrEmpty(r.Month),
+			Days:        sliceOrEmpty(r.Day),
+			Hours:       valueOrZero(r.Hour),
+			Description: valueOrZero(r.Rangedescr),
+		})
+	}
+	return schedule
+}
+
+// ToAPI maps the domain type back to a generated FirewallSchedule suitable for a request body.
+func (s *PFSenseFirewallSchedule) ToAPI() FirewallSchedule {
+	timeRanges := []FirewallScheduleTimeRange{}
+	for _, r := range s.TimeRanges {
+		timeRanges = append(timeRanges, FirewallScheduleTimeRange{
+			Position:   pointerTo(r.Weekdays),
+			Month:      pointerTo(r.Months),
+			Day:        pointerTo(r.Days),
+			Hour:       pointerTo(r.Hours),
+			Rangedescr: pointerTo(r.Description),
+		})
+	}
+	return FirewallSchedule{
+		Name:      pointerTo(s.Name),
+		Descr:     pointerTo(s.Description),
+		Timerange: &timeRanges,
+	}
+}
+
+// TrafficLimiterFromAPI maps a generated TrafficShaperLimiter to the domain type.
+func TrafficLimiterFromAPI(l TrafficShaperLimiter) *PFSenseTrafficLimiter {
+	limiter := &PFSenseTrafficLimiter{
+		ID:          valueOrZero(l.Id),
+		Name:        valueOrZero(l.Name),
+		Enabled:     valueOrZero(l.Enabled),
+		Description: valueOrZero(l.Description),
+		Bandwidths:  []PFSenseTrafficLimiterBandwidth{},
+		Mask:        string(valueOr(l.Mask, "none")),
+		MaskBits:    l.Maskbits,
+		MaskBitsV6:  l.Maskbitsv6,
+		Scheduler:   string(valueOr(l.Sched, "wf2q+")),
+		AQM:         string(valueOr(l.Aqm, "droptail")),
+		QueueLimit:  l.Qlimit,
+		ECN:         valueOrZero(l.Ecn),
+		Delay:       valueOrZero(l.Delay),
+		Queues:      []PFSenseTrafficLimiterQueue{},
+	}
+	for _, b := range sliceOrEmpty(l.Bandwidth) {
+		limiter.Bandwidths = append(limiter.Bandwidths, PFSenseTrafficLimiterBandwidth{
+			Bandwidth: valueOrZero(b.Bw),
+			Scale:     string(valueOr(b.Bwscale, "Mb")),
+			Schedule:  nilIfEmpty(b.Bwsched),
+		})
+	}
+	for _, q := range sliceOrEmpty(l.Queue) {
+		limiter.Queues = append(limiter.Queues, PFSenseTrafficLimiterQueue{
+			Name:        valueOrZero(q.Name),
+			Enabled:     valueOrZero(q.Enabled),
+			Description: valueOrZero(q.Description),
+			Mask:        string(valueOr(q.Mask, "none")),
+			MaskBits:    q.Maskbits,
+			MaskBitsV6:  q.Maskbitsv6,
+			Weight:      q.Weight,
+			AQM:         string(valueOr(q.Aqm, "droptail")),
+			QueueLimit:  q.Qlimit,
+			ECN:         valueOrZero(q.Ecn),
+		})
+	}
+	return limiter
+}
+
+// ToAPI maps the domain type back to a generated TrafficShaperLimiter suitable for a request body.
+func (l *PFSenseTrafficLimiter) ToAPI() TrafficShaperLimiter {
+	bandwidths := []TrafficShaperLimiterBandwidth{}
+	for _, b := range l.Bandwidths {
+		bandwidths = append(bandwidths, TrafficShaperLimiterBandwidth{
+			Bw:      pointerTo(b.Bandwidth),
+			Bwscale: pointerTo(TrafficShaperLimiterBandwidthBwscale(b.Scale)),
+			Bwsched: pointerTo(valueOrZero(b.Schedule)),
+		})
+	}
+	queues := []TrafficShaperLimiterQueue{}
+	for _, q := range l.Queues {
+		queues = append(queues, TrafficShaperLimiterQueue{
+			Name:        pointerTo(q.Name),
+			Enabled:     pointerTo(q.Enabled),
+			Description: pointerTo(q.Description),
+			Mask:        pointerTo(TrafficShaperLimiterQueueMask(q.Mask)),
+			Maskbits:    q.MaskBits,
+			Maskbitsv6:  q.MaskBitsV6,
+			Weight:      q.Weight,
+			Aqm:         pointerTo(TrafficShaperLimiterQueueAqm(q.AQM)),
+			Qlimit:      q.QueueLimit,
+			Ecn:         pointerTo(q.ECN),
+		})
+	}
+	return TrafficShaperLimiter{
+		Name:        pointerTo(l.Name),
+		Enabled:     pointerTo(l.Enabled),
+		Description: pointerTo(l.Description),
+		Mask:        pointerTo(TrafficShaperLimiterMask(l.Mask)),
+		Maskbits:    l.MaskBits,
+		Maskbitsv6:  l.MaskBitsV6,
+		Sched:       pointerTo(TrafficShaperLimiterSched(l.Scheduler)),
+		Aqm:         pointerTo(TrafficShaperLimiterAqm(l.AQM)),
+		Qlimit:      l.QueueLimit,
+		Ecn:         pointerTo(l.ECN),
+		Delay:       pointerTo(l.Delay),
+		Bandwidth:   &bandwidths,
+		Queue:       &queues,
+	}
+}
+
+// TrafficShaperFromAPI maps a generated TrafficShaper to the domain type.
+func TrafficShaperFromAPI(t TrafficShaper) *PFSenseTrafficShaper {
+	shaper := &PFSenseTrafficShaper{
+		ID:            valueOrZero(t.Id),
+		Interface:     valueOrZero(t.Interface),
+		Enabled:       valueOrZero(t.Enabled),
+		Scheduler:     string(valueOrZero(t.Scheduler)),
+		Bandwidth:     valueOrZero(t.Bandwidth),
+		BandwidthType: string(valueOr(t.Bandwidthtype, "Mb")),
+		QueueLimit:    t.Qlimit,
+		TBRSize:       t.Tbrconfig,
+		Queues:        []PFSenseTrafficShaperQueue{},
+	}
+	for _, q := range sliceOrEmpty(t.Queue) {
+		shaper.Queues = append(shaper.Queues, PFSenseTrafficShaperQueue{
+			Name:          valueOrZero(q.Name),
+			Enabled:       valueOrZero(q.Enabled),
+			Description:   valueOrZero(q.Description),
+			Priority:      q.Priority,
+			QueueLimit:    q.Qlimit,
+			Default:       valueOrZero(q.Default),
+			Bandwidth:     q.Bandwidth,
+			BandwidthType: enumString(q.Bandwidthtype),
+			RED:           valueOrZero(q.Red),
+			RIO:           valueOrZero(q.Rio),
+			ECN:           valueOrZero(q.Ecn),
+			CoDel:         valueOrZero(q.Codel),
+		})
+	}
+	return shaper
+}
+
+// ToAPI maps the domain type back to a generated TrafficShaper suitable for a request body.
+func (t *PFSenseTrafficShaper) ToAPI() TrafficShaper {
+	queues := []TrafficShaperQueue{}
+	for _, q := range t.Queues {
+		queues = append(queues, TrafficShaperQueue{
+			Name:          pointerTo(q.Name),
+			Enabled:       pointerTo(q.Enabled),
+			Description:   pointerTo(q.Description),
+			Priority:      q.Priority,
+			Qlimit:        q.QueueLimit,
+			Default:       pointerTo(q.Default),
+			Bandwidth:     q.Bandwidth,
+			Bandwidthtype: stringEnum[TrafficShaperQueueBandwidthtype](q.BandwidthType),
+			Red:           pointerTo(q.RED),
+			Rio:           pointerTo(q.RIO),
+			Ecn:           pointerTo(q.ECN),
+			Codel:         pointerTo(q.CoDel),
+		})
+	}
+	return TrafficShaper{
+		Interface:     pointerTo(t.Interface),
+		Enabled:       pointerTo(t.Enabled),
+		Scheduler:     pointerTo(TrafficShaperScheduler(t.Scheduler)),
+		Bandwidth:     pointerTo(t.Bandwidth),
+		Bandwidthtype: pointerTo(TrafficShaperBandwidthtype(t.BandwidthType)),
+		Qlimit:        t.QueueLimit,
+		Tbrconfig:     t.TBRSize,
+		Queue:         &queues,
+	}
+}
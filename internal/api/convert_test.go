@@ -0,0 +1,364 @@
+package pfsense_rest_v2
+
+import (
+	"testing"
+)
+
+func TestFirewallRuleFromAPI_Empty(t *testing.T) {
+	// A rule with no optional fields set must not panic and must map to
+	// explicit "any" semantics.
+	rule := FirewallRuleFromAPI(FirewallRule{})
+
+	if rule.Protocol != nil {
+		t.Errorf("expected nil protocol, got %q", *rule.Protocol)
+	}
+	if rule.SourcePort != nil || rule.DestinationPort != nil {
+		t.Errorf("expected nil ports, got %v/%v", rule.SourcePort, rule.DestinationPort)
+	}
+	if rule.Source != "any" || rule.Destination != "any" {
+		t.Errorf("expected any source/destination, got %q/%q", rule.Source, rule.Destination)
+	}
+	if rule.Interfaces == nil {
+		t.Error("expected empty, non-nil interfaces")
+	}
+}
+
+func TestFirewallRule_RoundTrip(t *testing.T) {
+	port := "443"
+	protocol := "tcp"
+	rule := &PFSenseFirewallRule{
+		Type:            "pass",
+		Interfaces:      []string{"lan"},
+		AddressFamily:   "inet",
+		Description:     "HTTPS",
+		Protocol:        &protocol,
+		Source:          "lan",
+		Destination:     "any",
+		DestinationPort: &port,
+	}
+
+	body := rule.ToAPI()
+	if body.SourcePort != nil {
+		t.Errorf("expected unset source port, got %q", *body.SourcePort)
+	}
+
+	got := FirewallRuleFromAPI(body)
+	if *got.Protocol != protocol || *got.DestinationPort != port || got.Description != rule.Description {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+}
+
+func TestFirewallRule_Schedule(t *testing.T) {
+	// Without a schedule the request must still clear any schedule pfSense has,
+	// and pfSense's empty answer must read back as no schedule.
+	body := (&PFSenseFirewallRule{}).ToAPI()
+	if body.Sched == nil || *body.Sched != "" {
+		t.Errorf("expected empty schedule in request, got %v", body.Sched)
+	}
+	if got := FirewallRuleFromAPI(body); got.Schedule != nil {
+		t.Errorf("expected nil schedule, got %q", *got.Schedule)
+	}
+
+	sched := "business_hours"
+	if got := FirewallRuleFromAPI((&PFSenseFirewallRule{Schedule: &sched}).ToAPI()); got.Schedule == nil || *got.Schedule != sched {
+		t.Errorf("expected schedule %q, got %v", sched, got.Schedule)
+	}
+}
+
+func TestFirewallSchedule_RoundTrip(t *testing.T) {
+	schedule := &PFSenseFirewallSchedule{
+		Name: "business_hours",
+		TimeRanges: []PFSenseFirewallScheduleTimeRange{
+			{Weekdays: []int{1, 2, 3}, Months: []int{}, Days: []int{}, Hours: "8:00-18:00"},
+		},
+	}
+
+	got := FirewallScheduleFromAPI(schedule.ToAPI())
+	if got.Name != schedule.Name || len(got.TimeRanges) != 1 || len(got.TimeRanges[0].Weekdays) != 3 || got.TimeRanges[0].Hours != "8:00-18:00" {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+	if empty := FirewallScheduleFromAPI(FirewallSchedule{}); empty.TimeRanges == nil {
+		t.Error("expected empty, non-nil time ranges")
+	}
+}
+
+func TestFirewallRule_Pipes(t *testing.T) {
+	// Unset pipes and queues must clear whatever pfSense has and read back as nil.
+	body := (&PFSenseFirewallRule{}).ToAPI()
+	if body.Dnpipe == nil || *body.Dnpipe != "" || body.Pdnpipe == nil || *body.Pdnpipe != "" || body.Defaultqueue == nil || *body.Defaultqueue != "" {
+		t.Errorf("expected empty pipes and queue in request, got %v %v %v", body.Dnpipe, body.Pdnpipe, body.Defaultqueue)
+	}
+	if got := FirewallRuleFromAPI(body); got.InPipe != nil || got.OutPipe != nil || got.DefaultQueue != nil {
+		t.Errorf("expected nil pipes and queue, got %+v", got)
+	}
+
+	in, out := "upload", "download"
+	got := FirewallRuleFromAPI((&PFSenseFirewallRule{InPipe: &in, OutPipe: &out}).ToAPI())
+	if got.InPipe == nil || *got.InPipe != in || got.OutPipe == nil || *got.OutPipe != out {
+		t.Errorf("pipe round trip mismatch: %+v", got)
+	}
+}
+
+func TestFirewallRule_Gateway(t *testing.T) {
+	// Without a gateway the request must clear any gateway pfSense has.
+	body := (&PFSenseFirewallRule{}).ToAPI()
+	if body.Gateway == nil || *body.Gateway != "" {
+		t.Errorf("expected empty gateway in request, got %v", body.Gateway)
+	}
+	if got := FirewallRuleFromAPI(body); got.Gateway != nil {
+		t.Errorf("expected nil gateway, got %q", *got.Gateway)
+	}
+
+	gateway := "WAN2_DHCP"
+	if got := FirewallRuleFromAPI((&PFSenseFirewallRule{Gateway: &gateway}).ToAPI()); got.Gateway == nil || *got.Gateway != gateway {
+		t.Errorf("expected gateway %q, got %v", gateway, got.Gateway)
+	}
+}
+
+func TestFirewallRule_ICMPTypes(t *testing.T) {
+	icmp := string(FirewallRuleProtocolIcmp)
+
+	// Matching any ICMP type is sent as pfSense's "any" and read back as no types.
+	body := (&PFSenseFirewallRule{Protocol: &icmp}).ToAPI()
+	if body.Icmptype == nil || len(*body.Icmptype) != 1 || (*body.Icmptype)[0] != "any" {
+		t.Errorf("expected icmptype [any], got %v", body.Icmptype)
+	}
+	if got := FirewallRuleFromAPI(body); got.ICMPTypes == nil || len(got.ICMPTypes) != 0 {
+		t.Errorf("expected empty ICMP types, got %v", got.ICMPTypes)
+	}
+
+	got := FirewallRuleFromAPI((&PFSenseFirewallRule{Protocol: &icmp, ICMPTypes: []string{"echoreq", "unreach"}}).ToAPI())
+	if len(got.ICMPTypes) != 2 || got.ICMPTypes[0] != "echoreq" {
+		t.Errorf("expected ICMP types to round trip, got %v", got.ICMPTypes)
+	}
+
+	// Other protocols must not send ICMP types at all.
+	if body := (&PFSenseFirewallRule{}).ToAPI(); body.Icmptype != nil {
+		t.Errorf("expected no icmptype without icmp protocol, got %v", *body.Icmptype)
+	}
+}
+
+func TestFirewallRule_Floating(t *testing.T) {
+	// Direction and quick are only meaningful on floating rules.
+	body := (&PFSenseFirewallRule{Direction: "in", Quick: true}).ToAPI()
+	if body.Direction != nil || body.Quick != nil || body.Floating == nil || *body.Floating {
+		t.Errorf("expected no floating options on an interface rule, got %+v", body)
+	}
+
+	rule := &PFSenseFirewallRule{Type: "match", Interfaces: []string{"wan", "lan"}, Floating: true, Direction: "out", Quick: false, StateType: "sloppy state"}
+	got := FirewallRuleFromAPI(rule.ToAPI())
+	if !got.Floating || got.Direction != "out" || got.Quick || got.StateType != "sloppy state" || len(got.Interfaces) != 2 {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+
+	if empty := FirewallRuleFromAPI(FirewallRule{}); empty.Direction != "any" || empty.StateType != "keep state" || empty.TCPFlagsSet == nil {
+		t.Errorf("unexpected defaults: %+v", empty)
+	}
+}
+
+func TestTrafficLimiter_RoundTrip(t *testing.T) {
+	sched := "business_hours"
+	limiter := &PFSenseTrafficLimiter{
+		Name:       "upload",
+		Enabled:    true,
+		Bandwidths: []PFSenseTrafficLimiterBandwidth{{Bandwidth: 10, Scale: "Mb"}, {Bandwidth: 50, Scale: "Mb", Schedule: &sched}},
+		Mask:       "srcaddress",
+		Scheduler:  "fq_codel",
+		AQM:        "droptail",
+		Queues:     []PFSenseTrafficLimiterQueue{{Name: "upload_low", Mask: "none", AQM: "droptail"}},
+	}
+
+	got := TrafficLimiterFromAPI(limiter.ToAPI())
+	if got.Name != limiter.Name || got.Mask != "srcaddress" || got.Scheduler != "fq_codel" || len(got.Bandwidths) != 2 || len(got.Queues) != 1 {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+	if got.Bandwidths[0].Schedule != nil || got.Bandwidths[1].Schedule == nil || *got.Bandwidths[1].Schedule != sched {
+		t.Errorf("bandwidth schedule mismatch: %+v", got.Bandwidths)
+	}
+
+	empty := TrafficLimiterFromAPI(TrafficShaperLimiter{})
+	if empty.Bandwidths == nil || empty.Queues == nil || empty.Mask != "none" || empty.Scheduler != "wf2q+" || empty.AQM != "droptail" {
+		t.Errorf("unexpected defaults: %+v", empty)
+	}
+}
+
+func TestTrafficShaper_RoundTrip(t *testing.T) {
+	bandwidth, bandwidthType := 20, "%"
+	shaper := &PFSenseTrafficShaper{
+		Interface:     "wan",
+		Enabled:       true,
+		Scheduler:     "PRIQ",
+		Bandwidth:     100,
+		BandwidthType: "Mb",
+		Queues: []PFSenseTrafficShaperQueue{
+			{Name: "qDefault", Default: true},
+			{Name: "qVoIP", Bandwidth: &bandwidth, BandwidthType: &bandwidthType},
+		},
+	}
+
+	got := TrafficShaperFromAPI(shaper.ToAPI())
+	if got.Interface != "wan" || got.Scheduler != "PRIQ" || got.Bandwidth != 100 || len(got.Queues) != 2 || !got.Queues[0].Default {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+	if got.Queues[0].BandwidthType != nil || got.Queues[1].BandwidthType == nil || *got.Queues[1].BandwidthType != "%" {
+		t.Errorf("queue bandwidth type mismatch: %+v", got.Queues)
+	}
+	if empty := TrafficShaperFromAPI(TrafficShaper{}); empty.Queues == nil || empty.BandwidthType != "Mb" {
+		t.Errorf("unexpected defaults: %+v", empty)
+	}
+}
+
+func TestDNSResolverHostOverride_RoundTrip(t *testing.T) {
+	override := &PFSenseDNSResolverHostOverride{
+		Host:    "nas",
+		Domain:  "home.arpa",
+		IPs:     []string{"192.168.1.20", "fd00::20"},
+		Aliases: []PFSenseDNSResolverHostOverrideAlias{{Host: "files", Domain: "home.arpa"}},
+	}
+
+	got := DNSResolverHostOverrideFromAPI(override.ToAPI())
+	if got.FQDN() != "nas.home.arpa" || len(got.IPs) != 2 || len(got.Aliases) != 1 || got.Aliases[0].Host != "files" {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+	if empty := DNSResolverHostOverrideFromAPI(DNSResolverHostOverride{Domain: pointerTo("home.arpa")}); empty.IPs == nil || empty.Aliases == nil || empty.FQDN() != "home.arpa" {
+		t.Errorf("unexpected defaults: %+v", empty)
+	}
+}
+
+func TestDNSResolverAccessList_RoundTrip(t *testing.T) {
+	list := &PFSenseDNSResolverAccessList{
+		Name:     "vpn_clients",
+		Action:   "allow snoop",
+		Networks: []PFSenseDNSResolverAccessListNetwork{{Network: "10.8.0.0", Mask: 24}},
+	}
+
+	got := DNSResolverAccessListFromAPI(list.ToAPI())
+	if got.Name != "vpn_clients" || got.Action != "allow snoop" || len(got.Networks) != 1 || got.Networks[0].Mask != 24 {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+	if empty := DNSResolverAccessListFromAPI(DNSResolverAccessList{}); empty.Networks == nil || empty.Action != "allow" {
+		t.Errorf("unexpected defaults: %+v", empty)
+	}
+}
+
+func TestDNSResolverSettingsFromAPI_Defaults(t *testing.T) {
+	settings := DNSResolverSettingsFromAPI(DNSResolverSettings{})
+	if settings.Port != "53" || len(settings.ActiveInterfaces) != 1 || settings.ActiveInterfaces[0] != "all" || settings.PythonOrder != "pre_validator" {
+		t.Errorf("unexpected defaults: %+v", settings)
+	}
+
+	settings.OutgoingInterfaces = []string{"wan"}
+	settings.Forwarding = true
+	got := DNSResolverSettingsFromAPI(settings.ToAPI())
+	if len(got.OutgoingInterfaces) != 1 || got.OutgoingInterfaces[0] != "wan" || !got.Forwarding {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+}
+
+func TestSystemDNS_RoundTrip(t *testing.T) {
+	gateway := "WAN_DHCP"
+	dns := &PFSenseSystemDNS{
+		Servers: []PFSenseSystemDNSServer{
+			{Address: "9.9.9.9", Gateway: &gateway},
+			{Address: "1.1.1.1"},
+		},
+	}
+
+	body := dns.ToAPI()
+	if gateways := *body.Dnsgw; len(gateways) != 2 || gateways[1] != "none" {
+		t.Errorf("expected a gateway per server, got %v", gateways)
+	}
+	got := SystemDNSFromAPI(body)
+	if len(got.Servers) != 2 || got.Servers[0].Gateway == nil || *got.Servers[0].Gateway != "WAN_DHCP" || got.Servers[1].Gateway != nil {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+	if empty := SystemDNSFromAPI(SystemDNS{}); empty.Servers == nil {
+		t.Errorf("unexpected defaults: %+v", empty)
+	}
+}
+
+func TestUser_RoundTrip(t *testing.T) {
+	user := &PFSenseUser{
+		Name:           "alice",
+		Privileges:     []string{"page-all"},
+		Expires:        "12/31/2027",
+		AuthorizedKeys: []string{"ssh-ed25519 AAAA alice@laptop", "ssh-ed25519 BBBB alice@desktop"},
+	}
+
+	body := user.ToAPI()
+	if body.Password != nil {
+		t.Errorf("expected an empty password to be left out, got %q", *body.Password)
+	}
+	got := UserFromAPI(body)
+	if got.Name != "alice" || got.Expires != "12/31/2027" || len(got.AuthorizedKeys) != 2 || got.AuthorizedKeys[1] != "ssh-ed25519 BBBB alice@desktop" {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+
+	user.Password = "correct horse"
+	if body := user.ToAPI(); body.Password == nil || *body.Password != "correct horse" {
+		t.Error("expected the password to be sent")
+	}
+	if empty := UserFromAPI(User{Authorizedkeys: pointerTo("\n")}); empty.AuthorizedKeys == nil || len(empty.AuthorizedKeys) != 0 || empty.Privileges == nil {
+		t.Errorf("unexpected defaults: %+v", empty)
+	}
+}
+
+func TestBaseConfigFromAPI_Empty(t *testing.T) {
+	config := BaseConfigFromAPI(SystemHostname{})
+	if config.Hostname != "" || config.Domain != "" {
+		t.Errorf("expected empty base config, got %+v", config)
+	}
+}
+
+func TestCertificate_RequestBodies(t *testing.T) {
+	request := &PFSenseCertificateRequest{
+		KeyType:      "ECDSA",
+		Curve:        "secp384r1",
+		LifetimeDays: 398,
+		Subject:      PFSenseCertificateSubject{CommonName: "vpn.example.com", Country: "NL"},
+		DNSNames:     []string{"vpn.example.com"},
+	}
+	certificate := &PFSenseCertificate{Description: "VPN", Type: "server", CARefID: "61a4b2c3d4e5f"}
+
+	generate := certificate.GenerateAPI(request)
+	if valueOrZero(generate.Caref) != "61a4b2c3d4e5f" || valueOrZero(generate.DnCommonname) != "vpn.example.com" || valueOrZero(generate.Ecname) != "secp384r1" {
+		t.Errorf("unexpected generate body: %+v", generate)
+	}
+	if generate.DnState != nil || generate.DnOrganization != nil {
+		t.Error("expected empty subject fields to be left out")
+	}
+	if csr := certificate.SigningRequestAPI(request); valueOrZero(csr.DnCountry) != "NL" || len(valueOrZero(csr.DnsSans)) != 1 {
+		t.Errorf("unexpected signing request body: %+v", csr)
+	}
+
+	if body := certificate.ToAPI(); body.Crt != nil || body.Prv != nil {
+		t.Error("expected an empty certificate and key to be left out")
+	}
+	if got := CertificateFromAPI(SystemCertificate{Refid: pointerTo("61a4b2c3d4e5f")}); got.Type != "server" || got.RefID != "61a4b2c3d4e5f" {
+		t.Errorf("unexpected defaults: %+v", got)
+	}
+
+	ca := &PFSenseCertificateAuthority{Description: "Intermediate", CARefID: "5f1e2a3b4c5d6"}
+	request.Intermediate = true
+	if body := ca.GenerateAPI(request); !valueOrZero(body.IsIntermediate) || valueOrZero(body.Caref) != "5f1e2a3b4c5d6" {
+		t.Errorf("unexpected CA generate body: %+v", body)
+	}
+	if body := ca.ToAPI(); body.Prv != nil {
+		t.Error("expected an empty CA key to be left out")
+	}
+}
+
+func TestWebGUISettings_RoundTrip(t *testing.T) {
+	settings := &PFSenseWebGUISettings{Protocol: "https", Port: "8443", SSLCertificateRefID: "61a4b2c3d4e5f", AntiLockout: false, DNSRebindCheck: true, HSTS: true}
+
+	body := settings.ToAPI()
+	if !valueOrZero(body.Noantilockout) || valueOrZero(body.Nodnsrebindcheck) || valueOrZero(body.Disablehsts) {
+		t.Errorf("expected the flags to be inverted, got %+v", body)
+	}
+	if got := WebGUISettingsFromAPI(body); *got != *settings {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+	if empty := WebGUISettingsFromAPI(SystemWebGUISettings{}); empty.Protocol != "https" || !empty.AntiLockout || !empty.HSTS {
+		t.Errorf("unexpected defaults: %+v", empty)
+	}
+}
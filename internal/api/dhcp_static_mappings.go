@@ -0,0 +1,121 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+type PFSenseDHCPStaticMapping struct {
+	ID int
+	// Interface is the DHCP server (interface ID) the mapping belongs to.
+	Interface  string
+	MACAddress string
+	// IPAddress, ClientID, Hostname and Domain are nil when unset.
+	IPAddress           *string
+	ClientID            *string
+	Hostname            *string
+	Domain              *string
+	Description         string
+	ARPTableStaticEntry bool
+}
+
+func (c *PFSenseClientV2) GetDHCPStaticMappings() ([]*PFSenseDHCPStaticMapping, error) {
+	limit := 0
+	response, err := c.apiClient.GetServicesDHCPServerStaticMappingsEndpointWithResponse(
+		context.Background(),
+		&GetServicesDHCPServerStaticMappingsEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving DHCP static mappings", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseDHCPStaticMapping{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, DHCPStaticMappingFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetDHCPStaticMapping(parentID string, id int) (*PFSenseDHCPStaticMapping, error) {
+	response, err := c.apiClient.GetServicesDHCPServerStaticMappingEndpointWithResponse(
+		context.Background(),
+		&GetServicesDHCPServerStaticMappingEndpointParams{
+			ParentId: parentID,
+			Id:       id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving DHCP static mapping", response.StatusCode(), response.Body)
+	}
+	return DHCPStaticMappingFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateDHCPStaticMapping(item *PFSenseDHCPStaticMapping) (*PFSenseDHCPStaticMapping, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostServicesDHCPServerStaticMappingEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating DHCP static mapping", response.StatusCode(), response.Body)
+	}
+	return DHCPStaticMappingFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateDHCPStaticMapping(item *PFSenseDHCPStaticMapping) (*PFSenseDHCPStaticMapping, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchServicesDHCPServerStaticMappingEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating DHCP static mapping", response.StatusCode(), response.Body)
+	}
+	return DHCPStaticMappingFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteDHCPStaticMapping(parentID string, id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteServicesDHCPServerStaticMappingEndpointWithResponse(
+		context.Background(),
+		&DeleteServicesDHCPServerStaticMappingEndpointParams{
+			ParentId: parentID,
+			Id:       id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting DHCP static mapping", response.StatusCode(), response.Body)
+	}
+	return nil
+}
+
+// ApplyDHCPServer restarts the DHCP server so pending static mapping changes take effect.
+func (c *PFSenseClientV2) ApplyDHCPServer() error {
+	response, err := c.apiClient.PostServicesDHCPServerApplyEndpointWithResponse(context.Background())
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("applying DHCP server changes", response.StatusCode(), response.Body)
+	}
+	return nil
+}
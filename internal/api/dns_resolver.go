@@ -0,0 +1,67 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// ApplyDNSResolver restarts the DNS resolver so pending override and access
+// list changes take effect. Requests made while a restart is in progress are
+// batched into one further restart.
+func (c *PFSenseClientV2) ApplyDNSResolver() error {
+	return c.dnsResolverApply.Do(func() error {
+		response, err := c.apiClient.PostServicesDNSResolverApplyEndpointWithResponse(context.Background())
+		if err != nil {
+			return err
+		}
+		if response.JSON200 == nil {
+			return responseError("applying DNS resolver changes", response.StatusCode(), response.Body)
+		}
+		return nil
+	})
+}
+
+// PFSenseDNSResolverSettings is the global DNS resolver (Unbound)
+// configuration. There is exactly one per device.
+type PFSenseDNSResolverSettings struct {
+	Enabled bool
+	Port    string
+	// ActiveInterfaces and OutgoingInterfaces hold interface IDs, or "all".
+	ActiveInterfaces   []string
+	OutgoingInterfaces []string
+	StrictOutgoing     bool
+	DNSSEC             bool
+	Forwarding         bool
+	ForwardTLSUpstream bool
+	RegisterDHCP       bool
+	RegisterDHCPStatic bool
+	RegisterOpenVPN    bool
+	CustomOptions      string
+	Python             bool
+	PythonOrder        string
+	PythonScript       string
+}
+
+func (c *PFSenseClientV2) GetDNSResolverSettings() (*PFSenseDNSResolverSettings, error) {
+	response, err := c.apiClient.GetServicesDNSResolverSettingsEndpointWithResponse(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving DNS resolver settings", response.StatusCode(), response.Body)
+	}
+	return DNSResolverSettingsFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateDNSResolverSettings(settings *PFSenseDNSResolverSettings) (*PFSenseDNSResolverSettings, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PatchServicesDNSResolverSettingsEndpointWithResponse(context.Background(), settings.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating DNS resolver settings", response.StatusCode(), response.Body)
+	}
+	return DNSResolverSettingsFromAPI(*response.JSON200.Data), nil
+}
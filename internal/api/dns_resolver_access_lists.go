@@ -0,0 +1,109 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseDNSResolverAccessList decides how the resolver treats queries from
+// Networks.
+type PFSenseDNSResolverAccessList struct {
+	ID          int
+	Name        string
+	Action      string
+	Description string
+	Networks    []PFSenseDNSResolverAccessListNetwork
+}
+
+type PFSenseDNSResolverAccessListNetwork struct {
+	Network     string
+	Mask        int
+	Description string
+}
+
+func (c *PFSenseClientV2) GetDNSResolverAccessLists() ([]*PFSenseDNSResolverAccessList, error) {
+	limit := 0
+	response, err := c.apiClient.GetServicesDNSResolverAccessListsEndpointWithResponse(
+		context.Background(),
+		&GetServicesDNSResolverAccessListsEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving DNS resolver access lists", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseDNSResolverAccessList{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, DNSResolverAccessListFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetDNSResolverAccessList(id int) (*PFSenseDNSResolverAccessList, error) {
+	response, err := c.apiClient.GetServicesDNSResolverAccessListEndpointWithResponse(
+		context.Background(),
+		&GetServicesDNSResolverAccessListEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving DNS resolver access list", response.StatusCode(), response.Body)
+	}
+	return DNSResolverAccessListFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateDNSResolverAccessList(item *PFSenseDNSResolverAccessList) (*PFSenseDNSResolverAccessList, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostServicesDNSResolverAccessListEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating DNS resolver access list", response.StatusCode(), response.Body)
+	}
+	return DNSResolverAccessListFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateDNSResolverAccessList(item *PFSenseDNSResolverAccessList) (*PFSenseDNSResolverAccessList, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchServicesDNSResolverAccessListEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating DNS resolver access list", response.StatusCode(), response.Body)
+	}
+	return DNSResolverAccessListFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteDNSResolverAccessList(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteServicesDNSResolverAccessListEndpointWithResponse(
+		context.Background(),
+		&DeleteServicesDNSResolverAccessListEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting DNS resolver access list", response.StatusCode(), response.Body)
+	}
+	return nil
+}
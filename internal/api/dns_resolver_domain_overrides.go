@@ -0,0 +1,106 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseDNSResolverDomainOverride forwards queries for Domain and its
+// subdomains to another DNS server.
+type PFSenseDNSResolverDomainOverride struct {
+	ID     int
+	Domain string
+	// Server is the address queries are forwarded to, optionally followed by
+	// @port.
+	Server             string
+	Description        string
+	ForwardTLSUpstream bool
+	TLSHostname        string
+}
+
+func (c *PFSenseClientV2) GetDNSResolverDomainOverrides() ([]*PFSenseDNSResolverDomainOverride, error) {
+	limit := 0
+	response, err := c.apiClient.GetServicesDNSResolverDomainOverridesEndpointWithResponse(
+		context.Background(),
+		&GetServicesDNSResolverDomainOverridesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving DNS resolver domain overrides", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseDNSResolverDomainOverride{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, DNSResolverDomainOverrideFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetDNSResolverDomainOverride(id int) (*PFSenseDNSResolverDomainOverride, error) {
+	response, err := c.apiClient.GetServicesDNSResolverDomainOverrideEndpointWithResponse(
+		context.Background(),
+		&GetServicesDNSResolverDomainOverrideEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving DNS resolver domain override", response.StatusCode(), response.Body)
+	}
+	return DNSResolverDomainOverrideFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateDNSResolverDomainOverride(item *PFSenseDNSResolverDomainOverride) (*PFSenseDNSResolverDomainOverride, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostServicesDNSResolverDomainOverrideEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating DNS resolver domain override", response.StatusCode(), response.Body)
+	}
+	return DNSResolverDomainOverrideFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateDNSResolverDomainOverride(item *PFSenseDNSResolverDomainOverride) (*PFSenseDNSResolverDomainOverride, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchServicesDNSResolverDomainOverrideEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating DNS resolver domain override", response.StatusCode(), response.Body)
+	}
+	return DNSResolverDomainOverrideFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteDNSResolverDomainOverride(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteServicesDNSResolverDomainOverrideEndpointWithResponse(
+		context.Background(),
+		&DeleteServicesDNSResolverDomainOverrideEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting DNS resolver domain override", response.StatusCode(), response.Body)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseDNSResolverHostOverride answers queries for Host.Domain, and for
+// each of its aliases, with IPs instead of forwarding them.
+type PFSenseDNSResolverHostOverride struct {
+	ID int
+	// Host may be empty to override Domain itself.
+	Host        string
+	Domain      string
+	IPs         []string
+	Description string
+	Aliases     []PFSenseDNSResolverHostOverrideAlias
+}
+
+type PFSenseDNSResolverHostOverrideAlias struct {
+	Host        string
+	Domain      string
+	Description string
+}
+
+// FQDN is the name the override answers for.
+func (o *PFSenseDNSResolverHostOverride) FQDN() string {
+	if o.Host == "" {
+		return o.Domain
+	}
+	return o.Host + "." + o.Domain
+}
+
+func (c *PFSenseClientV2) GetDNSResolverHostOverrides() ([]*PFSenseDNSResolverHostOverride, error) {
+	limit := 0
+	response, err := c.apiClient.GetServicesDNSResolverHostOverridesEndpointWithResponse(
+		context.Background(),
+		&GetServicesDNSResolverHostOverridesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving DNS resolver host overrides", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseDNSResolverHostOverride{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, DNSResolverHostOverrideFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetDNSResolverHostOverride(id int) (*PFSenseDNSResolverHostOverride, error) {
+	response, err := c.apiClient.GetServicesDNSResolverHostOverrideEndpointWithResponse(
+		context.Background(),
+		&GetServicesDNSResolverHostOverrideEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving DNS resolver host override", response.StatusCode(), response.Body)
+	}
+	return DNSResolverHostOverrideFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateDNSResolverHostOverride(item *PFSenseDNSResolverHostOverride) (*PFSenseDNSResolverHostOverride, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostServicesDNSResolverHostOverrideEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating DNS resolver host override", response.StatusCode(), response.Body)
+	}
+	return DNSResolverHostOverrideFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateDNSResolverHostOverride(item *PFSenseDNSResolverHostOverride) (*PFSenseDNSResolverHostOverride, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchServicesDNSResolverHostOverrideEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating DNS resolver host override", response.StatusCode(), response.Body)
+	}
+	return DNSResolverHostOverrideFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteDNSResolverHostOverride(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteServicesDNSResolverHostOverrideEndpointWithResponse(
+		context.Background(),
+		&DeleteServicesDNSResolverHostOverrideEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting DNS resolver host override", response.StatusCode(), response.Body)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// The REST API is served by the webGUI, so changing the webGUI protocol or
+// port moves the API. The generated client keeps the URL it was created with;
+// every request has its scheme and host rewritten to the current endpoint,
+// which can therefore be moved while other requests are in flight.
+
+var (
+	// reconnectTimeout is how long to wait for the API after the webGUI moved.
+	reconnectTimeout = 2 * time.Minute
+	// reconnectInterval is the delay between attempts to reach the moved API.
+	reconnectInterval = 2 * time.Second
+)
+
+// URL returns the URL the client currently talks to.
+func (c *PFSenseClientV2) URL() string {
+	return c.endpoint.Load().String()
+}
+
+// rewriteEndpoint is a request editor pointing the request at the current
+// endpoint.
+func (c *PFSenseClientV2) rewriteEndpoint(ctx context.Context, req *http.Request) error {
+	endpoint := c.endpoint.Load()
+	req.URL.Scheme = endpoint.Scheme
+	req.URL.Host = endpoint.Host
+	return nil
+}
+
+// movedEndpoint returns endpoint with its scheme and port replaced. The port
+// is left out when it is the default one for the protocol, and port "" means
+// the default port.
+func movedEndpoint(endpoint *url.URL, protocol string, port string) *url.URL {
+	moved := *endpoint
+	moved.Scheme = protocol
+	moved.Host = endpoint.Hostname()
+	if port == "" || (protocol == "https" && port == "443") || (protocol == "http" && port == "80") {
+		if strings.Contains(moved.Host, ":") {
+			moved.Host = "[" + moved.Host + "]"
+		}
+	} else {
+		moved.Host = net.JoinHostPort(moved.Host, port)
+	}
+	return &moved
+}
+
+// moveEndpoint points the client at moved and waits until the API answers
+// there. When it does not, the client goes back to the previous endpoint.
+func (c *PFSenseClientV2) moveEndpoint(moved *url.URL) error {
+	previous := c.endpoint.Swap(moved)
+
+	// The webGUI restarts in the background after the change, so the new
+	// endpoint may take a moment to answer.
+	deadline := time.Now().Add(reconnectTimeout)
+	for {
+		_, err := c.GetBaseConfig()
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			c.endpoint.Store(previous)
+			return fmt.Errorf("the API did not answer at the new webGUI URL %s: %w", moved, err)
+		}
+		time.Sleep(reconnectInterval)
+	}
+}
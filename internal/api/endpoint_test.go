@@ -0,0 +1,50 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestMovedEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		protocol string
+		port     string
+		want     string
+	}{
+		{"https://192.168.1.1", "https", "8443", "https://192.168.1.1:8443"},
+		{"https://192.168.1.1:8443", "https", "443", "https://192.168.1.1"},
+		{"https://fw.example.com:8443/", "http", "", "http://fw.example.com/"},
+		{"http://fw.example.com", "https", "80", "https://fw.example.com:80"},
+		{"https://[fd00::1]:8443", "https", "", "https://[fd00::1]"},
+		{"https://[fd00::1]", "https", "8443", "https://[fd00::1]:8443"},
+	}
+	for _, test := range tests {
+		endpoint, _ := url.Parse(test.endpoint)
+		if got := movedEndpoint(endpoint, test.protocol, test.port).String(); got != test.want {
+			t.Errorf("movedEndpoint(%s, %s, %q) = %s, want %s", test.endpoint, test.protocol, test.port, got, test.want)
+		}
+	}
+}
+
+func TestRewriteEndpoint(t *testing.T) {
+	client, err := NewPFSenseClientV2("https://192.168.1.1", &APIKeyAuth{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoint, _ := url.Parse("http://192.168.1.1:8080")
+	client.endpoint.Store(endpoint)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://192.168.1.1/api/v2/system/hostname", nil)
+	if err := client.rewriteEndpoint(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if got := req.URL.String(); got != "http://192.168.1.1:8080/api/v2/system/hostname" {
+		t.Errorf("unexpected request URL %s", got)
+	}
+	if client.URL() != "http://192.168.1.1:8080" {
+		t.Errorf("unexpected client URL %s", client.URL())
+	}
+}
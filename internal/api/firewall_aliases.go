@@ -0,0 +1,103 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+type PFSenseFirewallAlias struct {
+	ID          int
+	Name        string
+	Type        string
+	Description string
+	Addresses   []string
+	// Details holds a description for each entry in Addresses, by position.
+	Details []string
+}
+
+func (c *PFSenseClientV2) GetFirewallAliases() ([]*PFSenseFirewallAlias, error) {
+	limit := 0
+	response, err := c.apiClient.GetFirewallAliasesEndpointWithResponse(
+		context.Background(),
+		&GetFirewallAliasesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving firewall aliases", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseFirewallAlias{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, FirewallAliasFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetFirewallAlias(id int) (*PFSenseFirewallAlias, error) {
+	response, err := c.apiClient.GetFirewallAliasEndpointWithResponse(
+		context.Background(),
+		&GetFirewallAliasEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving firewall alias", response.StatusCode(), response.Body)
+	}
+	return FirewallAliasFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateFirewallAlias(item *PFSenseFirewallAlias) (*PFSenseFirewallAlias, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostFirewallAliasEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating firewall alias", response.StatusCode(), response.Body)
+	}
+	return FirewallAliasFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateFirewallAlias(item *PFSenseFirewallAlias) (*PFSenseFirewallAlias, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchFirewallAliasEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating firewall alias", response.StatusCode(), response.Body)
+	}
+	return FirewallAliasFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteFirewallAlias(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteFirewallAliasEndpointWithResponse(
+		context.Background(),
+		&DeleteFirewallAliasEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting firewall alias", response.StatusCode(), response.Body)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+type PFSenseNATPortForward struct {
+	ID            int
+	Interface     string
+	AddressFamily string
+	Protocol      string
+	Source        string
+	// SourcePort and DestinationPort are nil when the rule matches any port.
+	SourcePort      *string
+	Destination     string
+	DestinationPort *string
+	// Target and LocalPort are where matching traffic is redirected.
+	Target      string
+	LocalPort   *string
+	Disabled    bool
+	NoRDR       bool
+	NoSync      bool
+	Description string
+	// NATReflection is nil when the system default reflection mode applies.
+	NATReflection *string
+	// AssociatedRuleID links the port forward to its generated filter rule, if any.
+	AssociatedRuleID *string
+}
+
+// SameForward reports whether other is the same port forward as p. The
+// associated filter rule ID is unique, so it decides when p has one;
+// otherwise the interface, destination, target and description must match.
+func (p *PFSenseNATPortForward) SameForward(other *PFSenseNATPortForward) bool {
+	if rule := valueOrZero(p.AssociatedRuleID); rule != "" {
+		return valueOrZero(other.AssociatedRuleID) == rule
+	}
+	return p.Interface == other.Interface &&
+		p.Destination == other.Destination &&
+		valueOrZero(p.DestinationPort) == valueOrZero(other.DestinationPort) &&
+		p.Target == other.Target &&
+		p.Description == other.Description
+}
+
+func (c *PFSenseClientV2) GetNATPortForwards() ([]*PFSenseNATPortForward, error) {
+	limit := 0
+	response, err := c.apiClient.GetFirewallNATPortForwardsEndpointWithResponse(
+		context.Background(),
+		&GetFirewallNATPortForwardsEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving NAT port forwards", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseNATPortForward{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, NATPortForwardFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetNATPortForward(id int) (*PFSenseNATPortForward, error) {
+	response, err := c.apiClient.GetFirewallNATPortForwardEndpointWithResponse(
+		context.Background(),
+		&GetFirewallNATPortForwardEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving NAT port forward", response.StatusCode(), response.Body)
+	}
+	return NATPortForwardFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateNATPortForward(item *PFSenseNATPortForward) (*PFSenseNATPortForward, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostFirewallNATPortForwardEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating NAT port forward", response.StatusCode(), response.Body)
+	}
+	return NATPortForwardFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateNATPortForward(item *PFSenseNATPortForward) (*PFSenseNATPortForward, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchFirewallNATPortForwardEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating NAT port forward", response.StatusCode(), response.Body)
+	}
+	return NATPortForwardFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteNATPortForward(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteFirewallNATPortForwardEndpointWithResponse(
+		context.Background(),
+		&DeleteFirewallNATPortForwardEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting NAT port forward", response.StatusCode(), response.Body)
+	}
+	return nil
+}
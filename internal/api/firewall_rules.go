@@ -0,0 +1,160 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+type PFSenseFirewallRule struct {
+	// ID is the rule's position in config.xml and changes when earlier rules are removed.
+	ID int
+	// Tracker is assigned by pfSense when the rule is created and never changes.
+	Tracker       int
+	Type          string
+	Interfaces    []string
+	Disabled      bool
+	AddressFamily string
+	Log           bool
+	Description   string
+	// Protocol is nil when the rule matches any protocol.
+	Protocol *string
+	Source   string
+	// SourcePort and DestinationPort are nil when the rule matches any port.
+	SourcePort      *string
+	Destination     string
+	DestinationPort *string
+	// Schedule is the name of the schedule the rule is active during, or nil
+	// when the rule is always active.
+	Schedule *string
+	// InPipe and OutPipe name the limiters or limiter queues traffic is
+	// passed through in each direction, and DefaultQueue the shaper queue it
+	// is assigned to. Each is nil when unused.
+	InPipe       *string
+	OutPipe      *string
+	DefaultQueue *string
+	// AckQueue is the shaper queue TCP acknowledgements are assigned to, or
+	// nil when they share DefaultQueue.
+	AckQueue *string
+	// Gateway is the gateway or gateway group matching traffic is routed
+	// through, or nil to use the routing table.
+	Gateway *string
+	// ICMPTypes is empty when the rule matches any ICMP type.
+	ICMPTypes     []string
+	StateType     string
+	TCPFlagsAny   bool
+	TCPFlagsOutOf []string
+	TCPFlagsSet   []string
+	// Floating rules may apply to several interfaces in either direction.
+	// Direction and Quick only take effect on floating rules; other rules
+	// always match inbound traffic and stop evaluation at the first match.
+	Floating  bool
+	Direction string
+	Quick     bool
+	// AssociatedRuleID links a rule created by a NAT port forward to that
+	// port forward. It is set by pfSense and ignored in requests.
+	AssociatedRuleID *string
+}
+
+func (c *PFSenseClientV2) GetFirewallRules() ([]*PFSenseFirewallRule, error) {
+	limit := 0
+	response, err := c.apiClient.GetFirewallRulesEndpointWithResponse(
+		context.Background(),
+		&GetFirewallRulesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving firewall rules", response.StatusCode(), response.Body)
+	}
+
+	var rules = []*PFSenseFirewallRule{}
+	for _, r := range sliceOrEmpty(response.JSON200.Data) {
+		rules = append(rules, FirewallRuleFromAPI(r))
+	}
+
+	return rules, nil
+}
+
+func (c *PFSenseClientV2) GetFirewallRule(id int) (*PFSenseFirewallRule, error) {
+	response, err := c.apiClient.GetFirewallRuleEndpointWithResponse(
+		context.Background(),
+		&GetFirewallRuleEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving firewall rule", response.StatusCode(), response.Body)
+	}
+	return FirewallRuleFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateFirewallRule(rule *PFSenseFirewallRule) (*PFSenseFirewallRule, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostFirewallRuleEndpointWithResponse(context.Background(), rule.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating firewall rule", response.StatusCode(), response.Body)
+	}
+	return FirewallRuleFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateFirewallRule(rule *PFSenseFirewallRule) (*PFSenseFirewallRule, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := rule.ToAPI()
+	body.Id = pointerTo(rule.ID)
+	response, err := c.apiClient.PatchFirewallRuleEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating firewall rule", response.StatusCode(), response.Body)
+	}
+	return FirewallRuleFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteFirewallRule(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteFirewallRuleEndpointWithResponse(
+		context.Background(),
+		&DeleteFirewallRuleEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting firewall rule", response.StatusCode(), response.Body)
+	}
+	return nil
+}
+
+// ApplyFirewall reloads the filter so pending rule, alias and NAT changes take
+// effect, guarded by the safety check when it is enabled.
+func (c *PFSenseClientV2) ApplyFirewall() error {
+	return c.guardApply("firewall", nil, c.applyFirewall)
+}
+
+func (c *PFSenseClientV2) applyFirewall() error {
+	response, err := c.apiClient.PostFirewallApplyEndpointWithResponse(context.Background())
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("applying firewall changes", response.StatusCode(), response.Body)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+type PFSenseFirewallSchedule struct {
+	ID          int
+	Name        string
+	Description string
+	TimeRanges  []PFSenseFirewallScheduleTimeRange
+}
+
+// PFSenseFirewallScheduleTimeRange is active either on the given weekdays or
+// on the given dates, where Months and Days pair up by position.
+type PFSenseFirewallScheduleTimeRange struct {
+	// Weekdays run from 1 (Monday) to 7 (Sunday).
+	Weekdays []int
+	Months   []int
+	Days     []int
+	// Hours is the time of day the range is active, e.g. "9:00-17:00".
+	Hours       string
+	Description string
+}
+
+func (c *PFSenseClientV2) GetFirewallSchedules() ([]*PFSenseFirewallSchedule, error) {
+	limit := 0
+	response, err := c.apiClient.GetFirewallSchedulesEndpointWithResponse(
+		context.Background(),
+		&GetFirewallSchedulesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving firewall schedules", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseFirewallSchedule{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, FirewallScheduleFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetFirewallSchedule(id int) (*PFSenseFirewallSchedule, error) {
+	response, err := c.apiClient.GetFirewallScheduleEndpointWithResponse(
+		context.Background(),
+		&GetFirewallScheduleEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving firewall schedule", response.StatusCode(), response.Body)
+	}
+	return FirewallScheduleFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateFirewallSchedule(item *PFSenseFirewallSchedule) (*PFSenseFirewallSchedule, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostFirewallScheduleEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating firewall schedule", response.StatusCode(), response.Body)
+	}
+	return FirewallScheduleFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateFirewallSchedule(item *PFSenseFirewallSchedule) (*PFSenseFirewallSchedule, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchFirewallScheduleEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating firewall schedule", response.StatusCode(), response.Body)
+	}
+	return FirewallScheduleFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteFirewallSchedule(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteFirewallScheduleEndpointWithResponse(
+		context.Background(),
+		&DeleteFirewallScheduleEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting firewall schedule", response.StatusCode(), response.Body)
+	}
+	return nil
+}
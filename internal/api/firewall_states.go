@@ -0,0 +1,229 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// firewallStatesPageSize is the number of states requested at a time. State
+// tables can hold hundreds of thousands of entries, so they are listed in
+// pages rather than in a single response.
+const firewallStatesPageSize = 1000
+
+type PFSenseFirewallState struct {
+	// Interface is the device the state was created on (e.g. igb1), not the
+	// pfSense interface ID.
+	Interface string
+	Protocol  string
+	Direction string
+	// Source and Destination are addresses with their ports, as pfSense
+	// reports them (e.g. 192.168.1.10:51234).
+	Source      string
+	Destination string
+	State       string
+	Age         string
+	ExpiresIn   string
+	PacketsIn   int
+	PacketsOut  int
+	BytesIn     int
+	BytesOut    int
+}
+
+// PFSenseFirewallStateFilter selects states. Empty fields match every state.
+type PFSenseFirewallStateFilter struct {
+	// Interface is the device name states must have been created on.
+	Interface string
+	// Protocol is the protocol states must have, e.g. tcp.
+	Protocol string
+	// Source and Destination must occur in the state's address and port,
+	// so "192.168.1.10:" selects one host and "192.168.1." a /24. Note that
+	// "1.1.1.1:" also occurs in "11.1.1.1:", so callers that must select
+	// exact addresses check them with the state's SourceAddress and
+	// DestinationAddress.
+	Source      string
+	Destination string
+}
+
+func (f PFSenseFirewallStateFilter) query() *map[string]interface{} {
+	query := map[string]interface{}{}
+	if f.Interface != "" {
+		query["interface"] = f.Interface
+	}
+	if f.Protocol != "" {
+		query["protocol"] = f.Protocol
+	}
+	if f.Source != "" {
+		query["source__contains"] = f.Source
+	}
+	if f.Destination != "" {
+		query["destination__contains"] = f.Destination
+	}
+	return &query
+}
+
+// SourceAddress returns the address and port the state's source consists of.
+func (s *PFSenseFirewallState) SourceAddress() (netip.AddrPort, bool) {
+	return parseStateAddress(s.Source)
+}
+
+// DestinationAddress returns the address and port the state's destination
+// consists of.
+func (s *PFSenseFirewallState) DestinationAddress() (netip.AddrPort, bool) {
+	return parseStateAddress(s.Destination)
+}
+
+// parseStateAddress parses one end of a state as pfSense reports it:
+// 192.168.1.10:51234 or fd00::1[51234], followed by the translated address in
+// parentheses when the state was NATed.
+func parseStateAddress(val string) (netip.AddrPort, bool) {
+	val, _, _ = strings.Cut(strings.TrimSpace(val), " ")
+	var addr, port string
+	if strings.HasSuffix(val, "]") {
+		var ok bool
+		addr, port, ok = strings.Cut(strings.TrimSuffix(val, "]"), "[")
+		if !ok {
+			return netip.AddrPort{}, false
+		}
+	} else {
+		i := strings.LastIndex(val, ":")
+		if i < 0 {
+			return netip.AddrPort{}, false
+		}
+		addr, port = val[:i], val[i+1:]
+	}
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		return netip.AddrPort{}, false
+	}
+	number, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return netip.AddrPort{}, false
+	}
+	return netip.AddrPortFrom(ip, uint16(number)), true
+}
+
+// GetFirewallStates lists the states matching filter, requesting them one
+// page at a time.
+func (c *PFSenseClientV2) GetFirewallStates(filter PFSenseFirewallStateFilter) ([]*PFSenseFirewallState, error) {
+	var states = []*PFSenseFirewallState{}
+	for offset := 0; ; offset += firewallStatesPageSize {
+		limit := firewallStatesPageSize
+		response, err := c.apiClient.GetFirewallStatesEndpointWithResponse(
+			context.Background(),
+			&GetFirewallStatesEndpointParams{
+				Limit:  &limit,
+				Offset: &offset,
+				Query:  filter.query(),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if response.JSON200 == nil {
+			return nil, responseError("retrieving firewall states", response.StatusCode(), response.Body)
+		}
+
+		page := sliceOrEmpty(response.JSON200.Data)
+		for _, s := range page {
+			states = append(states, FirewallStateFromAPI(s))
+		}
+		if len(page) < firewallStatesPageSize {
+			return states, nil
+		}
+	}
+}
+
+// KillFirewallStates kills the given states, selecting each by its exact
+// interface, protocol, source and destination, and returns how many were
+// killed. States of the connections to the API are skipped, so the provider
+// never cuts itself off.
+func (c *PFSenseClientV2) KillFirewallStates(states []*PFSenseFirewallState) (int, error) {
+	api := c.apiConnection()
+
+	killed := 0
+	for _, state := range states {
+		if api.carries(state) {
+			continue
+		}
+
+		limit := 0
+		response, err := c.apiClient.DeleteFirewallStatesEndpointWithResponse(
+			context.Background(),
+			&DeleteFirewallStatesEndpointParams{
+				Limit: &limit,
+				Query: &map[string]interface{}{
+					"interface":   state.Interface,
+					"protocol":    state.Protocol,
+					"source":      state.Source,
+					"destination": state.Destination,
+				},
+			},
+		)
+		if err != nil {
+			return killed, err
+		}
+		if response.JSON200 == nil {
+			return killed, responseError("killing firewall states", response.StatusCode(), response.Body)
+		}
+		killed += len(sliceOrEmpty(response.JSON200.Data))
+	}
+	return killed, nil
+}
+
+// apiConnection describes the connections the provider makes to the API.
+type apiConnection struct {
+	// addresses are those the API endpoint's host resolves to; empty when it
+	// cannot be resolved, in which case any address is taken to be the API.
+	addresses []netip.Addr
+	port      uint16
+}
+
+// apiConnection returns the addresses and port the provider reaches the API
+// on.
+func (c *PFSenseClientV2) apiConnection() apiConnection {
+	endpoint := c.endpoint.Load()
+	connection := apiConnection{port: 443}
+	if endpoint.Scheme == "http" {
+		connection.port = 80
+	}
+	if port, err := strconv.ParseUint(endpoint.Port(), 10, 16); err == nil {
+		connection.port = uint16(port)
+	}
+
+	if addr, err := netip.ParseAddr(endpoint.Hostname()); err == nil {
+		connection.addresses = []netip.Addr{addr}
+		return connection
+	}
+	hosts, err := net.LookupHost(endpoint.Hostname())
+	if err != nil {
+		return connection
+	}
+	for _, host := range hosts {
+		if addr, err := netip.ParseAddr(host); err == nil {
+			connection.addresses = append(connection.addresses, addr.Unmap())
+		}
+	}
+	return connection
+}
+
+// carries reports whether state may belong to a connection to the API: a
+// TCP state with the API's address and port at either end.
+func (a apiConnection) carries(state *PFSenseFirewallState) bool {
+	if state.Protocol != "tcp" {
+		return false
+	}
+	for _, end := range []string{state.Source, state.Destination} {
+		address, ok := parseStateAddress(end)
+		if !ok || address.Port() != a.port {
+			continue
+		}
+		if len(a.addresses) == 0 || slices.Contains(a.addresses, address.Addr().Unmap()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package pfsense_rest_v2
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestFirewallStateFilter_Query(t *testing.T) {
+	query := *PFSenseFirewallStateFilter{Interface: "igb1", Protocol: "tcp", Source: "192.168.1.10:"}.query()
+	if len(query) != 3 || query["interface"] != "igb1" || query["protocol"] != "tcp" || query["source__contains"] != "192.168.1.10:" {
+		t.Errorf("unexpected query %v", query)
+	}
+	if query := *(PFSenseFirewallStateFilter{}).query(); len(query) != 0 {
+		t.Errorf("expected empty query, got %v", query)
+	}
+}
+
+func TestParseStateAddress(t *testing.T) {
+	cases := []struct {
+		val  string
+		want string
+		ok   bool
+	}{
+		{"192.168.1.10:51234", "192.168.1.10:51234", true},
+		{"192.168.1.10:51234 (203.0.113.5:40000)", "192.168.1.10:51234", true},
+		{"fd00::1[443]", "[fd00::1]:443", true},
+		{"fd00::1", "", false},
+		{"host:80", "", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		got, ok := parseStateAddress(c.val)
+		if ok != c.ok || (ok && got.String() != c.want) {
+			t.Errorf("parseStateAddress(%q) = %v, %v, want %s, %v", c.val, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestAPIConnectionCarries(t *testing.T) {
+	api := apiConnection{addresses: []netip.Addr{netip.MustParseAddr("192.168.1.1")}, port: 443}
+	cases := []struct {
+		state *PFSenseFirewallState
+		want  bool
+	}{
+		{&PFSenseFirewallState{Protocol: "tcp", Source: "192.168.1.50:51234", Destination: "192.168.1.1:443"}, true},
+		{&PFSenseFirewallState{Protocol: "tcp", Source: "192.168.1.1:443", Destination: "192.168.1.50:51234"}, true},
+		{&PFSenseFirewallState{Protocol: "tcp", Source: "192.168.1.50:51234", Destination: "192.168.1.1:22"}, false},
+		{&PFSenseFirewallState{Protocol: "tcp", Source: "192.168.1.50:51234", Destination: "192.168.1.11:443"}, false},
+		{&PFSenseFirewallState{Protocol: "udp", Source: "192.168.1.50:51234", Destination: "192.168.1.1:443"}, false},
+	}
+	for _, c := range cases {
+		if got := api.carries(c.state); got != c.want {
+			t.Errorf("carries(%s -> %s) = %v, want %v", c.state.Source, c.state.Destination, got, c.want)
+		}
+	}
+
+	// An endpoint that cannot be resolved protects every state to its port.
+	unresolved := apiConnection{port: 443}
+	if !unresolved.carries(&PFSenseFirewallState{Protocol: "tcp", Source: "10.0.0.5:51234", Destination: "10.0.0.1:443"}) {
+		t.Error("expected a state to the API port to be protected when the endpoint is unresolved")
+	}
+}
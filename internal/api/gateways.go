@@ -0,0 +1,69 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseGateway is a gateway as reported by the gateway status, which,
+// unlike the routing configuration, includes the gateways pfSense creates for
+// dynamically addressed interfaces (e.g. WAN_DHCP).
+type PFSenseGateway struct {
+	Name      string
+	SourceIP  string
+	MonitorIP string
+	// Status is pfSense's summary of the gateway's health, e.g. online or down.
+	Status string
+}
+
+type PFSenseGatewayGroup struct {
+	ID          int
+	Name        string
+	Trigger     string
+	Description string
+}
+
+func (c *PFSenseClientV2) GetGateways() ([]*PFSenseGateway, error) {
+	limit := 0
+	response, err := c.apiClient.GetStatusGatewaysEndpointWithResponse(
+		context.Background(),
+		&GetStatusGatewaysEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving gateways", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseGateway{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, GatewayFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetGatewayGroups() ([]*PFSenseGatewayGroup, error) {
+	limit := 0
+	response, err := c.apiClient.GetRoutingGatewayGroupsEndpointWithResponse(
+		context.Background(),
+		&GetRoutingGatewayGroupsEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving gateway groups", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseGatewayGroup{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, GatewayGroupFromAPI(item))
+	}
+
+	return items, nil
+}
@@ -0,0 +1,131 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+type PFSenseInterface struct {
+	// ID is the pfSense interface name (e.g. wan, lan, opt1).
+	ID string
+	// Port is the physical or virtual device backing the interface (e.g. igb0).
+	Port         string
+	Enabled      bool
+	Description  string
+	SpoofMAC     *string
+	MTU          *int
+	BlockPrivate bool
+	BlockBogons  bool
+	IPv4Type     string
+	IPv4Address  *string
+	IPv4Subnet   *int
+	IPv4Gateway  *string
+	IPv6Type     string
+	IPv6Address  *string
+	IPv6Subnet   *int
+	IPv6Gateway  *string
+}
+
+func (c *PFSenseClientV2) GetInterfaces() ([]*PFSenseInterface, error) {
+	limit := 0
+	response, err := c.apiClient.GetNetworkInterfacesEndpointWithResponse(
+		context.Background(),
+		&GetNetworkInterfacesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving interfaces", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseInterface{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, InterfaceFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetInterface(id string) (*PFSenseInterface, error) {
+	response, err := c.apiClient.GetNetworkInterfaceEndpointWithResponse(
+		context.Background(),
+		&GetNetworkInterfaceEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving interface", response.StatusCode(), response.Body)
+	}
+	return InterfaceFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateInterface(item *PFSenseInterface) (*PFSenseInterface, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostNetworkInterfaceEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating interface", response.StatusCode(), response.Body)
+	}
+	return InterfaceFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateInterface(item *PFSenseInterface) (*PFSenseInterface, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchNetworkInterfaceEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating interface", response.StatusCode(), response.Body)
+	}
+	return InterfaceFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteInterface(id string) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteNetworkInterfaceEndpointWithResponse(
+		context.Background(),
+		&DeleteNetworkInterfaceEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting interface", response.StatusCode(), response.Body)
+	}
+	return nil
+}
+
+// ApplyInterfaces reconfigures interfaces so pending interface changes take
+// effect, guarded by the safety check when it is enabled.
+func (c *PFSenseClientV2) ApplyInterfaces() error {
+	return c.guardApply("interface", nil, c.applyInterfaces)
+}
+
+func (c *PFSenseClientV2) applyInterfaces() error {
+	response, err := c.apiClient.PostInterfaceApplyEndpointWithResponse(context.Background())
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("applying interface changes", response.StatusCode(), response.Body)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"syscall"
+	"time"
+)
+
+var (
+	// packageRequestTimeout is how long an install or removal request may
+	// run before the provider stops waiting for its response and watches
+	// the package list instead.
+	packageRequestTimeout = 5 * time.Minute
+	// packageTimeout is how long an install or removal may take in total.
+	packageTimeout = 20 * time.Minute
+	// packageInterval is the delay between checks of the package list.
+	packageInterval = 10 * time.Second
+)
+
+// PFSenseInstalledPackage is a package installed on the device.
+type PFSenseInstalledPackage struct {
+	ID               int
+	Name             string
+	ShortName        string
+	Description      string
+	InstalledVersion string
+	LatestVersion    string
+	UpdateAvailable  bool
+}
+
+// PFSenseAvailablePackage is a package in the device's package repository.
+type PFSenseAvailablePackage struct {
+	Name         string
+	ShortName    string
+	Description  string
+	Version      string
+	Installed    bool
+	Dependencies []string
+}
+
+func (c *PFSenseClientV2) GetInstalledPackages() ([]*PFSenseInstalledPackage, error) {
+	limit := 0
+	response, err := c.apiClient.GetSystemPackagesEndpointWithResponse(
+		context.Background(),
+		&GetSystemPackagesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving installed packages", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseInstalledPackage{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, InstalledPackageFromAPI(item))
+	}
+
+	return items, nil
+}
+
+// GetInstalledPackage finds an installed package by name. Package IDs change
+// whenever a package is installed or removed, so packages are always looked
+// up by name.
+func (c *PFSenseClientV2) GetInstalledPackage(name string) (*PFSenseInstalledPackage, error) {
+	packages, err := c.GetInstalledPackages()
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range packages {
+		if item.Name == name {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("package %s: %w", name, ErrNotFound)
+}
+
+// GetAvailablePackages lists the packages in the device's package
+// repository, which the device fetches from the internet.
+func (c *PFSenseClientV2) GetAvailablePackages() ([]*PFSenseAvailablePackage, error) {
+	limit := 0
+	response, err := c.apiClient.GetSystemPackageAvailableEndpointWithResponse(
+		context.Background(),
+		&GetSystemPackageAvailableEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving available packages", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseAvailablePackage{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, AvailablePackageFromAPI(item))
+	}
+
+	return items, nil
+}
+
+// InstallPackage installs a package and its dependencies. An install can
+// outlast the request, so when the response is lost or the server gives up,
+// the package list is watched until the package shows up. Errors that mean
+// the request never reached the device are returned straight away.
+func (c *PFSenseClientV2) InstallPackage(name string) (*PFSenseInstalledPackage, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), packageRequestTimeout)
+	defer cancel()
+	response, err := c.apiClient.PostSystemPackageEndpointWithResponse(ctx, SystemPackage{Name: &name})
+	if err != nil && !responseLost(err) {
+		return nil, err
+	}
+	if err == nil {
+		if response.JSON200 != nil && response.JSON200.Data != nil {
+			return InstalledPackageFromAPI(*response.JSON200.Data), nil
+		}
+		if response.StatusCode() < http.StatusInternalServerError {
+			return nil, responseError("installing package", response.StatusCode(), response.Body)
+		}
+	}
+
+	var installed *PFSenseInstalledPackage
+	err = waitForPackages("installing package "+name, func(packages []*PFSenseInstalledPackage) bool {
+		index := slices.IndexFunc(packages, func(item *PFSenseInstalledPackage) bool { return item.Name == name })
+		if index < 0 {
+			return false
+		}
+		installed = packages[index]
+		return true
+	}, c.GetInstalledPackages)
+	return installed, err
+}
+
+// DeletePackage removes an installed package, watching the package list like
+// InstallPackage when the removal outlasts the request.
+func (c *PFSenseClientV2) DeletePackage(item *PFSenseInstalledPackage) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), packageRequestTimeout)
+	defer cancel()
+	response, err := c.apiClient.DeleteSystemPackageEndpointWithResponse(
+		ctx,
+		&DeleteSystemPackageEndpointParams{
+			Id: item.ID,
+		},
+	)
+	if err != nil && !responseLost(err) {
+		return err
+	}
+	if err == nil {
+		if response.JSON200 != nil {
+			return nil
+		}
+		if response.StatusCode() < http.StatusInternalServerError {
+			return responseError("removing package", response.StatusCode(), response.Body)
+		}
+	}
+
+	return waitForPackages("removing package "+item.Name, func(packages []*PFSenseInstalledPackage) bool {
+		return !slices.ContainsFunc(packages, func(installed *PFSenseInstalledPackage) bool { return installed.Name == item.Name })
+	}, c.GetInstalledPackages)
+}
+
+// responseLost reports whether err means the request was sent but its
+// response never came back: it timed out, or the connection was closed while
+// waiting. Other errors, such as a refused connection or a failed TLS
+// handshake, mean the device never received the request.
+func responseLost(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+// waitForPackages lists the installed packages until done returns true or
+// packageTimeout has passed. Listing can fail while pkg holds its lock, so
+// errors are retried as well.
+func waitForPackages(action string, done func([]*PFSenseInstalledPackage) bool, list func() ([]*PFSenseInstalledPackage, error)) error {
+	deadline := time.Now().Add(packageTimeout)
+	for {
+		packages, err := list()
+		if err == nil && done(packages) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			if err != nil {
+				return fmt.Errorf("%s: not finished after %s: %w", action, packageTimeout, err)
+			}
+			return fmt.Errorf("%s: not finished after %s", action, packageTimeout)
+		}
+		time.Sleep(packageInterval)
+	}
+}
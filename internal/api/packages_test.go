@@ -0,0 +1,54 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWaitForPackages(t *testing.T) {
+	defer func(timeout, interval time.Duration) { packageTimeout, packageInterval = timeout, interval }(packageTimeout, packageInterval)
+	packageTimeout, packageInterval = 50*time.Millisecond, time.Millisecond
+
+	// The list fails while pkg runs, then shows the package
+	calls := 0
+	list := func() ([]*PFSenseInstalledPackage, error) {
+		calls++
+		if calls < 3 {
+			return nil, errors.New("pkg is locked")
+		}
+		return []*PFSenseInstalledPackage{{Name: "pfSense-pkg-acme"}}, nil
+	}
+	installed := func(packages []*PFSenseInstalledPackage) bool { return len(packages) > 0 }
+	if err := waitForPackages("installing package", installed, list); err != nil || calls != 3 {
+		t.Errorf("unexpected result after %d calls: %v", calls, err)
+	}
+
+	never := func([]*PFSenseInstalledPackage) bool { return false }
+	if err := waitForPackages("installing package", never, list); err == nil {
+		t.Error("expected a timeout")
+	}
+}
+
+func TestResponseLost(t *testing.T) {
+	tests := []struct {
+		err  error
+		lost bool
+	}{
+		{&url.Error{Op: "Post", URL: "https://192.168.1.1/api/v2/system/package", Err: context.DeadlineExceeded}, true},
+		{&url.Error{Op: "Post", URL: "https://192.168.1.1/api/v2/system/package", Err: io.EOF}, true},
+		{&url.Error{Op: "Post", URL: "https://192.168.1.1/api/v2/system/package", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}, true},
+		{&url.Error{Op: "Post", URL: "https://192.168.1.1/api/v2/system/package", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}, false},
+		{&url.Error{Op: "Post", URL: "https://pfsense.invalid/api/v2/system/package", Err: &net.DNSError{Err: "no such host", Name: "pfsense.invalid"}}, false},
+	}
+	for _, test := range tests {
+		if got := responseLost(test.err); got != test.lost {
+			t.Errorf("responseLost(%v) = %v, want %v", test.err, got, test.lost)
+		}
+	}
+}
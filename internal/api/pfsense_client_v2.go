@@ -2,11 +2,19 @@ package pfsense_rest_v2
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
 )
 
+// ErrNotFound is returned when the requested object does not exist on the pfSense device.
+var ErrNotFound = errors.New("object not found")
+
 type (
 	Authorization interface {
 		ClientOption() ClientOption
@@ -21,8 +29,28 @@ type (
 )
 
 type PFSenseClientV2 struct {
-	url       string
+	// endpoint is the scheme and host requests are sent to; see URL.
+	endpoint  atomic.Pointer[url.URL]
 	apiClient *ClientWithResponses
+	// authMethod is the REST API name of the authentication the client uses.
+	authMethod string
+
+	// ValidateReferences makes resources check the aliases and interfaces
+	// they refer to against References while planning.
+	ValidateReferences bool
+
+	references       referenceCache
+	dnsResolverApply applyBatch
+
+	// probeClient never reuses connections, so it sees what a new client
+	// would; see guardApply.
+	probeClient    *ClientWithResponses
+	safetyCheck    bool
+	safetyBaseline int
+
+	configSnapshot bool
+	snapshotOnce   sync.Once
+	snapshotErr    error
 }
 
 type (
@@ -31,34 +59,52 @@ type (
 		Domain   string
 	}
 )
-type PFSenseFirewallRule struct {
-	Type            string
-	Interfaces      []string
-	Disabled        bool
-	AddressFamily   string
-	Log             bool
-	Description     string
-	Protocol        string
-	Source          string
-	SourcePort      string
-	Destination     string
-	DestinationPort string
-}
 
-func NewPFSenseClientV2(url string, auth Authorization, insecure bool) (*PFSenseClientV2, error) {
-	apiClient, err := NewClientWithResponses(
-		url,
+func NewPFSenseClientV2(endpoint string, auth Authorization, insecure bool) (*PFSenseClientV2, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported URL %q: expected an http or https URL", endpoint)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Devices usually serve the webGUI with a self-signed certificate.
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
+
+	probeTransport := transport.Clone()
+	probeTransport.DisableKeepAlives = true
+
+	client := &PFSenseClientV2{}
+	client.endpoint.Store(parsed)
+	switch auth.(type) {
+	case *BasicAuth:
+		client.authMethod = "BasicAuth"
+	case *APIKeyAuth:
+		client.authMethod = "KeyAuth"
+	}
+	client.apiClient, err = NewClientWithResponses(
+		endpoint,
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithRequestEditorFn(client.rewriteEndpoint),
 		auth.ClientOption(),
 		WithContentTypeJSON,
 	)
 	if err != nil {
 		return nil, err
-	} else {
-		return &PFSenseClientV2{
-			url:       url,
-			apiClient: apiClient,
-		}, nil
 	}
+	client.probeClient, err = NewClientWithResponses(
+		endpoint,
+		WithHTTPClient(&http.Client{Transport: probeTransport}),
+		WithRequestEditorFn(client.rewriteEndpoint),
+		auth.ClientOption(),
+		WithContentTypeJSON,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
 }
 
 func (c *PFSenseClientV2) GetBaseConfig() (*PFSenseBaseConfig, error) {
@@ -66,49 +112,34 @@ func (c *PFSenseClientV2) GetBaseConfig() (*PFSenseBaseConfig, error) {
 	if err != nil {
 		return nil, err
 	}
-	if response.JSON200 == nil {
-		return nil, fmt.Errorf("unexpected response retrieving base config: %v", response)
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving base config", response.StatusCode(), response.Body)
 	}
-	return &PFSenseBaseConfig{
-		Hostname: *response.JSON200.Data.Hostname,
-		Domain:   *response.JSON200.Data.Domain,
-	}, nil
+	return BaseConfigFromAPI(*response.JSON200.Data), nil
 }
 
-func (c *PFSenseClientV2) GetFirewallRules() ([]*PFSenseFirewallRule, error) {
-	limit := 0
-	response, err := c.apiClient.GetFirewallRulesEndpointWithResponse(
-		context.Background(),
-		&GetFirewallRulesEndpointParams{
-			Limit: &limit,
-		},
-	)
+// UpdateBaseConfig sets the device hostname and domain.
+func (c *PFSenseClientV2) UpdateBaseConfig(config *PFSenseBaseConfig) (*PFSenseBaseConfig, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PatchSystemHostnameEndpointWithResponse(context.Background(), config.ToAPI())
 	if err != nil {
 		return nil, err
 	}
-	if response.JSON200 == nil {
-		return nil, fmt.Errorf("unexpected response retrieving firewall rules: %v", response)
-	}
-	rulesJSON := response.JSON200.Data
-
-	var rules = []*PFSenseFirewallRule{}
-	for _, r := range *rulesJSON {
-		rules = append(rules, &PFSenseFirewallRule{
-			Type:            string(*r.Type),
-			Interfaces:      *r.Interface,
-			Disabled:        *r.Disabled,
-			AddressFamily:   string(*r.Ipprotocol),
-			Log:             *r.Log,
-			Description:     *r.Descr,
-			Protocol:        string(*r.Protocol),
-			Source:          *r.Source,
-			SourcePort:      *r.SourcePort,
-			Destination:     *r.Destination,
-			DestinationPort: *r.DestinationPort,
-		})
-	}
-
-	return rules, nil
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating base config", response.StatusCode(), response.Body)
+	}
+	return BaseConfigFromAPI(*response.JSON200.Data), nil
+}
+
+// responseError builds the error returned for a response that did not carry
+// the expected payload, including the status and body pfSense sent back.
+func responseError(action string, statusCode int, body []byte) error {
+	if statusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: %w", action, ErrNotFound)
+	}
+	return fmt.Errorf("unexpected response %s: HTTP %d: %s", action, statusCode, body)
 }
 
 func (auth *APIKeyAuth) ClientOption() ClientOption {
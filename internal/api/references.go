@@ -0,0 +1,117 @@
+package pfsense_rest_v2
+
+import (
+	"fmt"
+	"sync"
+)
+
+// References are the names rules and port forwards can refer to: firewall
+// alias types keyed by alias name, and interface IDs.
+type References struct {
+	Aliases    map[string]string
+	Interfaces map[string]bool
+}
+
+// referenceCache holds the device's aliases and interfaces, listed once per
+// client, and the aliases resources have announced while planning. Gateways
+// are listed separately, and only once a rule uses one.
+type referenceCache struct {
+	mu       sync.Mutex
+	device   *References
+	planned  map[string]string
+	gateways map[string]bool
+}
+
+// References returns the aliases and interfaces on the device together with
+// the aliases recorded by PlanFirewallAlias. The device is only listed on the
+// first call, so a plan touching many resources makes two requests in total.
+func (c *PFSenseClientV2) References() (*References, error) {
+	c.references.mu.Lock()
+	defer c.references.mu.Unlock()
+
+	if c.references.device == nil {
+		device, err := c.listReferences()
+		if err != nil {
+			return nil, err
+		}
+		c.references.device = device
+	}
+
+	refs := &References{
+		Aliases:    make(map[string]string, len(c.references.device.Aliases)+len(c.references.planned)),
+		Interfaces: c.references.device.Interfaces,
+	}
+	for name, aliasType := range c.references.device.Aliases {
+		refs.Aliases[name] = aliasType
+	}
+	for name, aliasType := range c.references.planned {
+		refs.Aliases[name] = aliasType
+	}
+	return refs, nil
+}
+
+// PlanFirewallAlias records an alias that the current run will create or
+// rename, so references to it are accepted before it exists on the device.
+func (c *PFSenseClientV2) PlanFirewallAlias(name string, aliasType string) {
+	c.references.mu.Lock()
+	defer c.references.mu.Unlock()
+
+	if c.references.planned == nil {
+		c.references.planned = map[string]string{}
+	}
+	c.references.planned[name] = aliasType
+}
+
+// GatewayNames returns the names rules can route through: the gateways
+// pfSense reports status for and the gateway groups. The device is only
+// listed on the first call.
+func (c *PFSenseClientV2) GatewayNames() (map[string]bool, error) {
+	c.references.mu.Lock()
+	defer c.references.mu.Unlock()
+
+	if c.references.gateways != nil {
+		return c.references.gateways, nil
+	}
+
+	gateways, err := c.GetGateways()
+	if err != nil {
+		return nil, fmt.Errorf("listing gateways: %w", err)
+	}
+	groups, err := c.GetGatewayGroups()
+	if err != nil {
+		return nil, fmt.Errorf("listing gateway groups: %w", err)
+	}
+
+	names := make(map[string]bool, len(gateways)+len(groups))
+	for _, gateway := range gateways {
+		names[gateway.Name] = true
+	}
+	for _, group := range groups {
+		names[group.Name] = true
+	}
+	c.references.gateways = names
+	return names, nil
+}
+
+func (c *PFSenseClientV2) listReferences() (*References, error) {
+	aliases, err := c.GetFirewallAliases()
+	if err != nil {
+		return nil, fmt.Errorf("listing firewall aliases: %w", err)
+	}
+	interfaces, err := c.GetInterfaces()
+	if err != nil {
+		return nil, fmt.Errorf("listing interfaces: %w", err)
+	}
+
+	refs := &References{
+		Aliases:    make(map[string]string, len(aliases)),
+		Interfaces: make(map[string]bool, len(interfaces)),
+	}
+	for _, alias := range aliases {
+		refs.Aliases[alias.Name] = alias.Type
+	}
+	for _, iface := range interfaces {
+		refs.Interfaces[iface.ID] = true
+	}
+	return refs, nil
+}
@@ -0,0 +1,40 @@
+package pfsense_rest_v2
+
+import (
+	"testing"
+)
+
+func TestReferences_IncludesPlannedAliases(t *testing.T) {
+	client := &PFSenseClientV2{}
+	// Pre-load the device listing so no request is made.
+	client.references.device = &References{
+		Aliases:    map[string]string{"web_servers": "host"},
+		Interfaces: map[string]bool{"lan": true},
+	}
+	client.PlanFirewallAlias("web_ports", "port")
+
+	refs, err := client.References()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refs.Aliases["web_servers"] != "host" || refs.Aliases["web_ports"] != "port" || !refs.Interfaces["lan"] {
+		t.Errorf("unexpected references %+v", refs)
+	}
+	if _, ok := client.references.device.Aliases["web_ports"]; ok {
+		t.Error("planned alias leaked into the device listing")
+	}
+}
+
+func TestGatewayNames_Cached(t *testing.T) {
+	client := &PFSenseClientV2{}
+	// Pre-load the listing so no request is made.
+	client.references.gateways = map[string]bool{"WAN_DHCP": true, "failover": true}
+
+	names, err := client.GatewayNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !names["WAN_DHCP"] || !names["failover"] || names["WAN2_DHCP"] {
+		t.Errorf("unexpected gateway names %v", names)
+	}
+}
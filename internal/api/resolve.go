@@ -0,0 +1,245 @@
+package pfsense_rest_v2
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// REST v2 identifies most objects by their position in config.xml, so removing
+// one object renumbers every object after it. The Resolve* methods look an
+// object up by an attribute that survives renumbering and report when its ID
+// has moved, so callers never read or modify an unrelated object.
+
+// IDDrift records that an object was found at a different pfSense ID than the
+// caller expected.
+type IDDrift struct {
+	Kind       string
+	Key        string
+	ExpectedID int
+	ActualID   int
+}
+
+func (d *IDDrift) String() string {
+	return fmt.Sprintf("%s %s moved from pfSense ID %d to %d", d.Kind, d.Key, d.ExpectedID, d.ActualID)
+}
+
+// resolveByKey fetches the object at expectedID and checks it with matches. If
+// the object is missing or does not match, every object is listed and searched
+// for the one that does. When hasKey is false there is nothing stable to check
+// against (e.g. straight after an import by ID), so the object at expectedID is
+// trusted as-is.
+func resolveByKey[T any](
+	kind string,
+	key string,
+	hasKey bool,
+	expectedID int,
+	get func(int) (T, error),
+	list func() ([]T, error),
+	id func(T) int,
+	matches func(T) bool,
+) (T, *IDDrift, error) {
+	var zero T
+
+	item, err := get(expectedID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return zero, nil, err
+	}
+	if err == nil && (!hasKey || matches(item)) {
+		return item, nil, nil
+	}
+	if !hasKey {
+		return zero, nil, err
+	}
+
+	items, err := list()
+	if err != nil {
+		return zero, nil, err
+	}
+	for _, item := range items {
+		if matches(item) {
+			return item, &IDDrift{Kind: kind, Key: key, ExpectedID: expectedID, ActualID: id(item)}, nil
+		}
+	}
+	return zero, nil, fmt.Errorf("%s %s: %w", kind, key, ErrNotFound)
+}
+
+// ResolveFirewallRule finds a rule by its tracker, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveFirewallRule(id int, tracker int) (*PFSenseFirewallRule, *IDDrift, error) {
+	return resolveByKey(
+		"firewall rule", "with tracker "+strconv.Itoa(tracker), tracker != 0, id,
+		c.GetFirewallRule,
+		c.GetFirewallRules,
+		func(rule *PFSenseFirewallRule) int { return rule.ID },
+		func(rule *PFSenseFirewallRule) bool { return rule.Tracker == tracker },
+	)
+}
+
+// ResolveFirewallAlias finds an alias by its name, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveFirewallAlias(id int, name string) (*PFSenseFirewallAlias, *IDDrift, error) {
+	return resolveByKey(
+		"firewall alias", strconv.Quote(name), name != "", id,
+		c.GetFirewallAlias,
+		c.GetFirewallAliases,
+		func(alias *PFSenseFirewallAlias) int { return alias.ID },
+		func(alias *PFSenseFirewallAlias) bool { return alias.Name == name },
+	)
+}
+
+// ResolveFirewallSchedule finds a schedule by its name, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveFirewallSchedule(id int, name string) (*PFSenseFirewallSchedule, *IDDrift, error) {
+	return resolveByKey(
+		"firewall schedule", strconv.Quote(name), name != "", id,
+		c.GetFirewallSchedule,
+		c.GetFirewallSchedules,
+		func(schedule *PFSenseFirewallSchedule) int { return schedule.ID },
+		func(schedule *PFSenseFirewallSchedule) bool { return schedule.Name == name },
+	)
+}
+
+// ResolveTrafficLimiter finds a limiter by its name, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveTrafficLimiter(id int, name string) (*PFSenseTrafficLimiter, *IDDrift, error) {
+	return resolveByKey(
+		"traffic limiter", strconv.Quote(name), name != "", id,
+		c.GetTrafficLimiter,
+		c.GetTrafficLimiters,
+		func(limiter *PFSenseTrafficLimiter) int { return limiter.ID },
+		func(limiter *PFSenseTrafficLimiter) bool { return limiter.Name == name },
+	)
+}
+
+// ResolveTrafficShaper finds the shaper of an interface, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveTrafficShaper(id int, iface string) (*PFSenseTrafficShaper, *IDDrift, error) {
+	return resolveByKey(
+		"traffic shaper", "on "+iface, iface != "", id,
+		c.GetTrafficShaper,
+		c.GetTrafficShapers,
+		func(shaper *PFSenseTrafficShaper) int { return shaper.ID },
+		func(shaper *PFSenseTrafficShaper) bool { return shaper.Interface == iface },
+	)
+}
+
+// ResolveDHCPStaticMapping finds a static mapping by its interface and MAC
+// address, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveDHCPStaticMapping(iface string, id int, mac string) (*PFSenseDHCPStaticMapping, *IDDrift, error) {
+	return resolveByKey(
+		"DHCP static mapping", fmt.Sprintf("for %s on %s", mac, iface), mac != "", id,
+		func(id int) (*PFSenseDHCPStaticMapping, error) { return c.GetDHCPStaticMapping(iface, id) },
+		c.GetDHCPStaticMappings,
+		func(mapping *PFSenseDHCPStaticMapping) int { return mapping.ID },
+		func(mapping *PFSenseDHCPStaticMapping) bool {
+			return mapping.Interface == iface && strings.EqualFold(mapping.MACAddress, mac)
+		},
+	)
+}
+
+// ResolveDNSResolverHostOverride finds a host override by the name it answers
+// for, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveDNSResolverHostOverride(id int, host string, domain string) (*PFSenseDNSResolverHostOverride, *IDDrift, error) {
+	fqdn := (&PFSenseDNSResolverHostOverride{Host: host, Domain: domain}).FQDN()
+	return resolveByKey(
+		"DNS resolver host override", "for "+fqdn, domain != "", id,
+		c.GetDNSResolverHostOverride,
+		c.GetDNSResolverHostOverrides,
+		func(o *PFSenseDNSResolverHostOverride) int { return o.ID },
+		func(o *PFSenseDNSResolverHostOverride) bool { return o.Host == host && o.Domain == domain },
+	)
+}
+
+// ResolveDNSResolverDomainOverride finds a domain override by its domain and
+// server, starting from the ID it was last seen at. A domain may be
+// forwarded to several servers, so the domain alone is not unique.
+func (c *PFSenseClientV2) ResolveDNSResolverDomainOverride(id int, domain string, server string) (*PFSenseDNSResolverDomainOverride, *IDDrift, error) {
+	return resolveByKey(
+		"DNS resolver domain override", fmt.Sprintf("for %s to %s", domain, server), domain != "", id,
+		c.GetDNSResolverDomainOverride,
+		c.GetDNSResolverDomainOverrides,
+		func(o *PFSenseDNSResolverDomainOverride) int { return o.ID },
+		func(o *PFSenseDNSResolverDomainOverride) bool { return o.Domain == domain && o.Server == server },
+	)
+}
+
+// ResolveDNSResolverAccessList finds an access list by its name, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveDNSResolverAccessList(id int, name string) (*PFSenseDNSResolverAccessList, *IDDrift, error) {
+	return resolveByKey(
+		"DNS resolver access list", strconv.Quote(name), name != "", id,
+		c.GetDNSResolverAccessList,
+		c.GetDNSResolverAccessLists,
+		func(l *PFSenseDNSResolverAccessList) int { return l.ID },
+		func(l *PFSenseDNSResolverAccessList) bool { return l.Name == name },
+	)
+}
+
+// ResolveUser finds a user by its name, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveUser(id int, name string) (*PFSenseUser, *IDDrift, error) {
+	return resolveByKey(
+		"user", strconv.Quote(name), name != "", id,
+		c.GetUser,
+		c.GetUsers,
+		func(user *PFSenseUser) int { return user.ID },
+		func(user *PFSenseUser) bool { return user.Name == name },
+	)
+}
+
+// ResolveUserGroup finds a group by its name, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveUserGroup(id int, name string) (*PFSenseUserGroup, *IDDrift, error) {
+	return resolveByKey(
+		"user group", strconv.Quote(name), name != "", id,
+		c.GetUserGroup,
+		c.GetUserGroups,
+		func(group *PFSenseUserGroup) int { return group.ID },
+		func(group *PFSenseUserGroup) bool { return group.Name == name },
+	)
+}
+
+// ResolveCertificateAuthority finds a CA by its refid, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveCertificateAuthority(id int, refID string) (*PFSenseCertificateAuthority, *IDDrift, error) {
+	return resolveByKey(
+		"certificate authority", refID, refID != "", id,
+		c.GetCertificateAuthority,
+		c.GetCertificateAuthorities,
+		func(ca *PFSenseCertificateAuthority) int { return ca.ID },
+		func(ca *PFSenseCertificateAuthority) bool { return ca.RefID == refID },
+	)
+}
+
+// ResolveCertificate finds a certificate by its refid, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveCertificate(id int, refID string) (*PFSenseCertificate, *IDDrift, error) {
+	return resolveByKey(
+		"certificate", refID, refID != "", id,
+		c.GetCertificate,
+		c.GetCertificates,
+		func(certificate *PFSenseCertificate) int { return certificate.ID },
+		func(certificate *PFSenseCertificate) bool { return certificate.RefID == refID },
+	)
+}
+
+// ResolveSystemTunable finds a tunable by its sysctl name, starting from the ID it was last seen at.
+func (c *PFSenseClientV2) ResolveSystemTunable(id int, name string) (*PFSenseSystemTunable, *IDDrift, error) {
+	return resolveByKey(
+		"system tunable", strconv.Quote(name), name != "", id,
+		c.GetSystemTunable,
+		c.GetSystemTunables,
+		func(tunable *PFSenseSystemTunable) int { return tunable.ID },
+		func(tunable *PFSenseSystemTunable) bool { return tunable.Name == name },
+	)
+}
+
+// ResolveNATPortForward finds a port forward by the filter rule it is
+// associated with or, when it has none, by what it forwards, starting from
+// the ID it was last seen at. The interface is required, so an empty one
+// means nothing is known about the port forward yet.
+func (c *PFSenseClientV2) ResolveNATPortForward(id int, known *PFSenseNATPortForward) (*PFSenseNATPortForward, *IDDrift, error) {
+	key := fmt.Sprintf("on %s to %s", known.Interface, known.Destination)
+	if known.DestinationPort != nil {
+		key += " port " + *known.DestinationPort
+	}
+	return resolveByKey(
+		"NAT port forward", key, known.Interface != "", id,
+		c.GetNATPortForward,
+		c.GetNATPortForwards,
+		func(pf *PFSenseNATPortForward) int { return pf.ID },
+		known.SameForward,
+	)
+}
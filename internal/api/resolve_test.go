@@ -0,0 +1,90 @@
+package pfsense_rest_v2
+
+import (
+	"errors"
+	"testing"
+)
+
+type keyed struct {
+	id  int
+	key string
+}
+
+func resolveKeyed(items []keyed, expectedID int, key string) (keyed, *IDDrift, error) {
+	return resolveByKey(
+		"item", key, key != "", expectedID,
+		func(id int) (keyed, error) {
+			if id < 0 || id >= len(items) {
+				return keyed{}, ErrNotFound
+			}
+			return items[id], nil
+		},
+		func() ([]keyed, error) { return items, nil },
+		func(item keyed) int { return item.id },
+		func(item keyed) bool { return item.key == key },
+	)
+}
+
+func TestResolveByKey_Unchanged(t *testing.T) {
+	items := []keyed{{0, "a"}, {1, "b"}}
+	item, drift, err := resolveKeyed(items, 1, "b")
+	if err != nil || drift != nil || item.key != "b" {
+		t.Errorf("expected b without drift, got %v, %v, %v", item, drift, err)
+	}
+}
+
+func TestResolveByKey_Renumbered(t *testing.T) {
+	// "a" was removed, so "c" moved from ID 2 to ID 1 and ID 2 no longer exists.
+	items := []keyed{{0, "b"}, {1, "c"}}
+	item, drift, err := resolveKeyed(items, 2, "c")
+	if err != nil || item.key != "c" {
+		t.Fatalf("expected c, got %v, %v", item, err)
+	}
+	if drift == nil || drift.ExpectedID != 2 || drift.ActualID != 1 {
+		t.Errorf("expected drift from 2 to 1, got %v", drift)
+	}
+}
+
+func TestResolveByKey_WrongObjectAtID(t *testing.T) {
+	// ID 0 now holds a different object; it must not be returned.
+	items := []keyed{{0, "b"}, {1, "a"}}
+	item, drift, err := resolveKeyed(items, 0, "a")
+	if err != nil || item.key != "a" || drift == nil {
+		t.Errorf("expected a with drift, got %v, %v, %v", item, drift, err)
+	}
+}
+
+func TestResolveByKey_Missing(t *testing.T) {
+	items := []keyed{{0, "b"}}
+	_, _, err := resolveKeyed(items, 0, "a")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestResolveByKey_NoKey(t *testing.T) {
+	items := []keyed{{0, "a"}}
+	item, drift, err := resolveKeyed(items, 0, "")
+	if err != nil || drift != nil || item.key != "a" {
+		t.Errorf("expected object at ID without drift, got %v, %v, %v", item, drift, err)
+	}
+}
+
+func TestNATPortForwardSameForward(t *testing.T) {
+	known := &PFSenseNATPortForward{Interface: "wan", Destination: "wan:ip", DestinationPort: pointerTo("443"), Target: "10.0.0.5"}
+	if !known.SameForward(&PFSenseNATPortForward{ID: 3, Interface: "wan", Destination: "wan:ip", DestinationPort: pointerTo("443"), Target: "10.0.0.5"}) {
+		t.Error("expected an identical port forward to match")
+	}
+	if known.SameForward(&PFSenseNATPortForward{Interface: "wan", Destination: "wan:ip", DestinationPort: pointerTo("8443"), Target: "10.0.0.5"}) {
+		t.Error("expected a port forward with another destination port not to match")
+	}
+
+	// The associated rule decides even when the port forward was edited.
+	known.AssociatedRuleID = pointerTo("nat_5f1a")
+	if !known.SameForward(&PFSenseNATPortForward{Interface: "wan", Destination: "wan:ip", DestinationPort: pointerTo("8443"), Target: "10.0.0.6", AssociatedRuleID: pointerTo("nat_5f1a")}) {
+		t.Error("expected the port forward with the same associated rule to match")
+	}
+	if known.SameForward(&PFSenseNATPortForward{Interface: "wan", Destination: "wan:ip", DestinationPort: pointerTo("443"), Target: "10.0.0.5"}) {
+		t.Error("expected a port forward without the associated rule not to match")
+	}
+}
@@ -0,0 +1,93 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// PFSenseRESTAPISettings configures the REST API package the provider talks
+// through.
+type PFSenseRESTAPISettings struct {
+	// AuthMethods are "BasicAuth", "JWTAuth" and "KeyAuth".
+	AuthMethods []string
+	// AllowedInterfaces are the interfaces the API answers on; empty allows
+	// all of them.
+	AllowedInterfaces []string
+	// ReadOnly rejects every request that is not a GET.
+	ReadOnly bool
+	// JWTExpiry is how long a JWT stays valid, in seconds.
+	JWTExpiry       int
+	LoginProtection bool
+	// HASync copies the settings, users and keys to HASyncHosts.
+	HASync                     bool
+	HASyncValidateCertificates bool
+	HASyncHosts                []string
+	HASyncUsername             string
+	// HASyncPassword is never read back, and only sent when not empty.
+	HASyncPassword string
+}
+
+func (c *PFSenseClientV2) GetRESTAPISettings() (*PFSenseRESTAPISettings, error) {
+	response, err := c.apiClient.GetSystemRESTAPISettingsEndpointWithResponse(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving REST API settings", response.StatusCode(), response.Body)
+	}
+	return RESTAPISettingsFromAPI(*response.JSON200.Data), nil
+}
+
+// UpdateRESTAPISettings changes the REST API settings. Settings that would
+// stop the API from accepting the provider's own requests are refused with
+// ErrLockout.
+func (c *PFSenseClientV2) UpdateRESTAPISettings(settings *PFSenseRESTAPISettings) (*PFSenseRESTAPISettings, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	if err := c.checkRESTAPIAccess(settings); err != nil {
+		return nil, err
+	}
+
+	response, err := c.apiClient.PatchSystemRESTAPISettingsEndpointWithResponse(context.Background(), settings.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating REST API settings", response.StatusCode(), response.Body)
+	}
+	return RESTAPISettingsFromAPI(*response.JSON200.Data), nil
+}
+
+// checkRESTAPIAccess verifies that settings keep accepting the provider's
+// authentication method on the interface it reaches the API on. When that
+// interface cannot be determined, the interface restriction is only refused
+// with the safety check enabled.
+func (c *PFSenseClientV2) checkRESTAPIAccess(settings *PFSenseRESTAPISettings) error {
+	if !slices.Contains(settings.AuthMethods, c.authMethod) {
+		return fmt.Errorf("%w: the provider authenticates with %s, which the authentication methods %v leave out",
+			ErrLockout, c.authMethod, settings.AuthMethods)
+	}
+	if len(settings.AllowedInterfaces) == 0 {
+		return nil
+	}
+
+	interfaces, err := c.GetInterfaces()
+	if err != nil {
+		return err
+	}
+	endpoint := c.endpoint.Load()
+	access, err := findManagementAccess(endpoint.Hostname(), endpoint.Port(), endpoint.Scheme, interfaces)
+	if err != nil {
+		if c.safetyCheck {
+			return err
+		}
+		return nil
+	}
+	if !slices.Contains(settings.AllowedInterfaces, access.Interface) {
+		return fmt.Errorf("%w: the provider reaches the API on %s, which the allowed interfaces %v leave out",
+			ErrLockout, access.Interface, settings.AllowedInterfaces)
+	}
+	return nil
+}
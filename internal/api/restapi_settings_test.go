@@ -0,0 +1,32 @@
+package pfsense_rest_v2
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckRESTAPIAccess_AuthMethods(t *testing.T) {
+	client, err := NewPFSenseClientV2("https://192.168.1.1", &APIKeyAuth{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without an interface restriction only the authentication method is checked
+	if err := client.checkRESTAPIAccess(&PFSenseRESTAPISettings{AuthMethods: []string{"BasicAuth", "KeyAuth"}}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := client.checkRESTAPIAccess(&PFSenseRESTAPISettings{AuthMethods: []string{"BasicAuth", "JWTAuth"}}); !errors.Is(err, ErrLockout) {
+		t.Errorf("expected dropping KeyAuth to be refused, got %v", err)
+	}
+}
+
+func TestRESTAPISettings_HASyncPassword(t *testing.T) {
+	settings := &PFSenseRESTAPISettings{AuthMethods: []string{"KeyAuth"}}
+	if body := settings.ToAPI(); body.HaSyncPassword != nil {
+		t.Errorf("expected an empty password to be left out, got %q", *body.HaSyncPassword)
+	}
+	settings.HASyncPassword = "secret"
+	if body := settings.ToAPI(); body.HaSyncPassword == nil || *body.HaSyncPassword != "secret" {
+		t.Errorf("expected the password to be sent, got %v", body.HaSyncPassword)
+	}
+}
@@ -0,0 +1,331 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The safety check guards the changes that can cut the provider off from the
+// API it talks through: firewall and interface applies and webGUI changes.
+// Before such a change takes effect it checks that a pass rule (or the
+// anti-lockout rule) still lets the API's port through on the interface the
+// API is reached on. Afterwards it probes the API over a new connection, and
+// when that fails it restores the configuration last known to leave the API
+// reachable: the one in effect when the safety check was enabled or after the
+// last guarded change that passed the probe. Only the changes made since then
+// are reverted, and the error names each of them.
+//
+// The rollback is sent over the client's kept-alive connection: pf keeps
+// passing an established connection after loading rules that would block a
+// new one, so the device can usually still be told to restore.
+
+// ErrLockout is returned when a change was refused or rolled back because it
+// would lock the provider out of the API.
+var ErrLockout = errors.New("change would lock the provider out of the API")
+
+var (
+	// probeTimeout is how long the API may take to answer after a change.
+	probeTimeout = 30 * time.Second
+	// probeInterval is the delay between probes.
+	probeInterval = 2 * time.Second
+)
+
+// managementAccess is how the provider reaches the API.
+type managementAccess struct {
+	// Interface is the pfSense interface the API address belongs to.
+	Interface string
+	Address   netip.Addr
+	Port      int
+}
+
+// EnableSafetyCheck turns on the safety check for the rest of the run. It
+// records the newest configuration revision, so the configuration in effect
+// now can be found again for a rollback.
+func (c *PFSenseClientV2) EnableSafetyCheck() error {
+	baseline, err := c.newestConfigRevision()
+	if err != nil {
+		return err
+	}
+	c.safetyBaseline = baseline
+	c.safetyCheck = true
+	return nil
+}
+
+// newestConfigRevision returns the time of the newest configuration revision.
+func (c *PFSenseClientV2) newestConfigRevision() (int, error) {
+	revisions, err := c.GetConfigRevisions()
+	if err != nil {
+		return 0, err
+	}
+	newest := 0
+	for _, revision := range revisions {
+		newest = max(newest, revision.Time)
+	}
+	return newest, nil
+}
+
+// guardApply performs apply, which makes pending changes described by what
+// take effect. webGUI is the webGUI configuration in effect afterwards, or nil
+// when it does not change. The API is probed afterwards whether or not apply
+// succeeded, and the configuration is rolled back when it cannot be reached.
+// A change that passes the probe becomes the new rollback target, so a later
+// rollback leaves it in place.
+func (c *PFSenseClientV2) guardApply(what string, webGUI *PFSenseWebGUISettings, apply func() error) error {
+	if !c.safetyCheck {
+		return apply()
+	}
+
+	if err := c.checkManagementAccess(webGUI); err != nil {
+		return fmt.Errorf("refusing to apply %s changes, which stay pending: %w", what, err)
+	}
+
+	// A failed apply is probed as well: when the change cut the connection,
+	// its own response is the first thing lost.
+	applyErr := apply()
+	probeErr := c.probeAPI()
+	if probeErr == nil {
+		if applyErr == nil {
+			// When the revisions cannot be read, the previous target is
+			// kept; a later rollback then reverts this change as well and
+			// names it.
+			if baseline, err := c.newestConfigRevision(); err == nil {
+				c.safetyBaseline = baseline
+			}
+		}
+		return applyErr
+	}
+	if applyErr != nil {
+		probeErr = fmt.Errorf("%v, after the apply failed with: %v", probeErr, applyErr)
+	}
+	reverted, err := c.rollback()
+	if err != nil {
+		return fmt.Errorf("%w: the API could not be reached after applying %s changes (%v), and restoring the last configuration that left it reachable failed: %v",
+			ErrLockout, what, probeErr, err)
+	}
+	return fmt.Errorf("%w: the API could not be reached after applying %s changes (%v); the last configuration that left it reachable was restored, reverting these changes: %s",
+		ErrLockout, what, probeErr, strings.Join(reverted, "; "))
+}
+
+// checkManagementAccess verifies that the configuration, with webGUI in
+// effect when it is not nil, lets the provider reach the API.
+func (c *PFSenseClientV2) checkManagementAccess(webGUI *PFSenseWebGUISettings) error {
+	var err error
+	if webGUI == nil {
+		webGUI, err = c.GetWebGUISettings()
+		if err != nil {
+			return err
+		}
+	}
+	interfaces, err := c.GetInterfaces()
+	if err != nil {
+		return err
+	}
+	rules, err := c.GetFirewallRules()
+	if err != nil {
+		return err
+	}
+
+	endpoint := movedEndpoint(c.endpoint.Load(), webGUI.Protocol, webGUI.Port)
+	access, err := findManagementAccess(endpoint.Hostname(), endpoint.Port(), endpoint.Scheme, interfaces)
+	if err != nil {
+		return err
+	}
+	return checkManagementRules(access, webGUI.AntiLockout, interfaces, rules)
+}
+
+// findManagementAccess finds the interface whose address host is.
+func findManagementAccess(host string, port string, protocol string, interfaces []*PFSenseInterface) (*managementAccess, error) {
+	access := &managementAccess{Port: 443}
+	if protocol == "http" {
+		access.Port = 80
+	}
+	if port != "" {
+		access.Port, _ = strconv.Atoi(port)
+	}
+
+	addresses := []netip.Addr{}
+	if address, err := netip.ParseAddr(host); err == nil {
+		addresses = append(addresses, address)
+	} else {
+		resolved, err := net.DefaultResolver.LookupNetIP(context.Background(), "ip", host)
+		if err != nil {
+			return nil, fmt.Errorf("%w: resolving the API host %s: %v", ErrLockout, host, err)
+		}
+		addresses = resolved
+	}
+
+	for _, address := range addresses {
+		address = address.Unmap()
+		for _, iface := range interfaces {
+			for _, configured := range []*string{iface.IPv4Address, iface.IPv6Address} {
+				if configured == nil {
+					continue
+				}
+				if ifaceAddress, err := netip.ParseAddr(*configured); err == nil && ifaceAddress == address {
+					access.Interface = iface.ID
+					access.Address = address
+					return access, nil
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: the API host %s is not a static interface address, so the interface the provider reaches the API on cannot be checked",
+		ErrLockout, host)
+}
+
+// checkManagementRules evaluates the rules on the management interface the
+// way pf does, quick floating rules first and then the interface's own rules,
+// and fails unless traffic to the API is passed. The source of the provider's
+// connection is not known, so a pass rule restricted to a source is taken to
+// cover it and a block rule restricted to a source is not.
+func checkManagementRules(access *managementAccess, antiLockout bool, interfaces []*PFSenseInterface, rules []*PFSenseFirewallRule) error {
+	// The anti-lockout rule passes webGUI traffic on LAN, or on WAN when
+	// there is no LAN, ahead of all other rules.
+	antiLockoutInterface := "wan"
+	if slices.ContainsFunc(interfaces, func(iface *PFSenseInterface) bool { return iface.ID == "lan" }) {
+		antiLockoutInterface = "lan"
+	}
+	if antiLockout && access.Interface == antiLockoutInterface {
+		return nil
+	}
+
+	ordered := []*PFSenseFirewallRule{}
+	for _, rule := range rules {
+		if rule.Floating && rule.Quick && rule.Direction != "out" && slices.Contains(rule.Interfaces, access.Interface) {
+			ordered = append(ordered, rule)
+		}
+	}
+	for _, rule := range rules {
+		if !rule.Floating && slices.Contains(rule.Interfaces, access.Interface) {
+			ordered = append(ordered, rule)
+		}
+	}
+
+	for _, rule := range ordered {
+		if rule.Disabled || !ruleMatchesAccess(rule, access) {
+			continue
+		}
+		if rule.Type == "pass" {
+			return nil
+		}
+		if rule.Source == "any" {
+			return fmt.Errorf("%w: rule %q (tracker %d) %ss TCP port %d on %s before any rule passes it",
+				ErrLockout, rule.Description, rule.Tracker, rule.Type, access.Port, access.Interface)
+		}
+	}
+	return fmt.Errorf("%w: no pass rule lets TCP port %d through on %s, where the provider reaches the API",
+		ErrLockout, access.Port, access.Interface)
+}
+
+// ruleMatchesAccess reports whether rule certainly matches traffic to the
+// API, leaving its source aside. Rules whose match depends on an alias or a
+// schedule are not known to match.
+func ruleMatchesAccess(rule *PFSenseFirewallRule, access *managementAccess) bool {
+	switch rule.AddressFamily {
+	case "inet":
+		if !access.Address.Is4() {
+			return false
+		}
+	case "inet6":
+		if !access.Address.Is6() {
+			return false
+		}
+	}
+	if rule.Protocol != nil && *rule.Protocol != "tcp" && *rule.Protocol != "tcp/udp" {
+		return false
+	}
+	if rule.Schedule != nil {
+		return false
+	}
+	if rule.DestinationPort != nil {
+		from, to, found := strings.Cut(*rule.DestinationPort, ":")
+		if !found {
+			to = from
+		}
+		low, errLow := strconv.Atoi(from)
+		high, errHigh := strconv.Atoi(to)
+		if errLow != nil || errHigh != nil || access.Port < low || access.Port > high {
+			return false
+		}
+	}
+
+	switch rule.Destination {
+	case "any", "(self)", access.Interface + ":ip", access.Address.String():
+		return true
+	}
+	if prefix, err := netip.ParsePrefix(rule.Destination); err == nil {
+		return prefix.Contains(access.Address)
+	}
+	return false
+}
+
+// probeAPI checks that the API answers a new connection, retrying until
+// probeTimeout while the change settles.
+func (c *PFSenseClientV2) probeAPI() error {
+	deadline := time.Now().Add(probeTimeout)
+	for {
+		response, err := c.probeClient.GetSystemHostnameEndpointWithResponse(context.Background())
+		if err == nil && response.JSON200 != nil {
+			return nil
+		}
+		if err == nil {
+			err = responseError("probing the API", response.StatusCode(), response.Body)
+		}
+		if time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(probeInterval)
+	}
+}
+
+// rollback restores the configuration the rollback target was recorded for.
+// The oldest revision newer than the target is the configuration the first
+// change since then replaced. It returns the descriptions of the changes it
+// reverted, oldest first.
+func (c *PFSenseClientV2) rollback() ([]string, error) {
+	revisions, err := c.GetConfigRevisions()
+	if err != nil {
+		return nil, err
+	}
+	target, reverted := revertedRevisions(revisions, c.safetyBaseline)
+	if target == nil {
+		return nil, fmt.Errorf("no configuration revision newer than %d was found", c.safetyBaseline)
+	}
+	// The change that wrote the current configuration has no revision yet.
+	current, err := c.runCommand(context.Background(), "retrieving the current configuration revision",
+		`php -r 'require_once("config.inc"); echo config_get_path("revision/description");'`)
+	if err != nil {
+		return nil, err
+	}
+	reverted = append(reverted, strings.TrimSpace(current))
+	return reverted, c.RestoreConfigRevision(target.Time)
+}
+
+// revertedRevisions finds the revision that restores the configuration in
+// effect at baseline, and the descriptions of the changes written after it,
+// oldest first. Each revision is described by the change that wrote it, so
+// the target's own description belongs to a change before baseline.
+func revertedRevisions(revisions []*PFSenseConfigRevision, baseline int) (*PFSenseConfigRevision, []string) {
+	newer := []*PFSenseConfigRevision{}
+	for _, revision := range revisions {
+		if revision.Time > baseline {
+			newer = append(newer, revision)
+		}
+	}
+	if len(newer) == 0 {
+		return nil, nil
+	}
+	slices.SortFunc(newer, func(a, b *PFSenseConfigRevision) int { return a.Time - b.Time })
+	reverted := []string{}
+	for _, revision := range newer[1:] {
+		reverted = append(reverted, revision.Description)
+	}
+	return newer[0], reverted
+}
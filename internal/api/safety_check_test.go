@@ -0,0 +1,88 @@
+package pfsense_rest_v2
+
+import (
+	"errors"
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestFindManagementAccess(t *testing.T) {
+	interfaces := []*PFSenseInterface{
+		{ID: "wan", IPv4Address: pointerTo("203.0.113.2")},
+		{ID: "lan", IPv4Address: pointerTo("192.168.1.1"), IPv6Address: pointerTo("fd00::1")},
+	}
+
+	access, err := findManagementAccess("192.168.1.1", "", "https", interfaces)
+	if err != nil || access.Interface != "lan" || access.Port != 443 {
+		t.Errorf("unexpected access %+v, %v", access, err)
+	}
+	access, err = findManagementAccess("fd00::1", "8080", "http", interfaces)
+	if err != nil || access.Interface != "lan" || access.Port != 8080 || !access.Address.Is6() {
+		t.Errorf("unexpected access %+v, %v", access, err)
+	}
+	if _, err := findManagementAccess("10.0.0.1", "", "https", interfaces); !errors.Is(err, ErrLockout) {
+		t.Errorf("expected an unknown address to be refused, got %v", err)
+	}
+}
+
+func TestCheckManagementRules(t *testing.T) {
+	interfaces := []*PFSenseInterface{{ID: "wan"}, {ID: "lan"}}
+	access := &managementAccess{Interface: "opt1", Address: netip.MustParseAddr("10.0.0.1"), Port: 8443}
+	pass := func(destination string, port *string) *PFSenseFirewallRule {
+		return &PFSenseFirewallRule{Type: "pass", Interfaces: []string{"opt1"}, Protocol: pointerTo("tcp"), Source: "192.168.9.0/24", Destination: destination, DestinationPort: port}
+	}
+	block := &PFSenseFirewallRule{Type: "block", Interfaces: []string{"opt1"}, Source: "any", Destination: "any", Description: "deny all"}
+
+	tests := []struct {
+		name        string
+		access      *managementAccess
+		antiLockout bool
+		rules       []*PFSenseFirewallRule
+		ok          bool
+	}{
+		{"no rules", access, false, nil, false},
+		{"anti-lockout on LAN", &managementAccess{Interface: "lan", Address: access.Address, Port: 443}, true, nil, true},
+		{"anti-lockout elsewhere", access, true, nil, false},
+		{"pass to self", access, false, []*PFSenseFirewallRule{pass("(self)", pointerTo("8443"))}, true},
+		{"pass port range", access, false, []*PFSenseFirewallRule{pass("opt1:ip", pointerTo("8000:9000"))}, true},
+		{"pass other port", access, false, []*PFSenseFirewallRule{pass("any", pointerTo("443"))}, false},
+		{"pass port alias", access, false, []*PFSenseFirewallRule{pass("any", pointerTo("admin_ports"))}, false},
+		{"pass network", access, false, []*PFSenseFirewallRule{pass("10.0.0.0/24", nil)}, true},
+		{"block first", access, false, []*PFSenseFirewallRule{block, pass("any", nil)}, false},
+		{"disabled block", access, false, []*PFSenseFirewallRule{{Type: "block", Disabled: true, Interfaces: []string{"opt1"}, Source: "any", Destination: "any"}, pass("any", nil)}, true},
+		{"block from a source", access, false, []*PFSenseFirewallRule{{Type: "block", Interfaces: []string{"opt1"}, Source: "198.51.100.0/24", Destination: "any"}, pass("any", nil)}, true},
+		{"IPv6 only rule", access, false, []*PFSenseFirewallRule{{Type: "pass", Interfaces: []string{"opt1"}, AddressFamily: "inet6", Source: "any", Destination: "any"}}, false},
+		{"quick floating block", access, false, []*PFSenseFirewallRule{pass("any", nil), {Type: "block", Floating: true, Quick: true, Direction: "any", Interfaces: []string{"opt1"}, Source: "any", Destination: "any"}}, false},
+		{"scheduled pass", access, false, []*PFSenseFirewallRule{{Type: "pass", Interfaces: []string{"opt1"}, Source: "any", Destination: "any", Schedule: pointerTo("office")}}, false},
+	}
+	for _, test := range tests {
+		err := checkManagementRules(test.access, test.antiLockout, interfaces, test.rules)
+		if test.ok && err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+		if !test.ok && !errors.Is(err, ErrLockout) {
+			t.Errorf("%s: expected a lockout error, got %v", test.name, err)
+		}
+	}
+}
+
+func TestRevertedRevisions(t *testing.T) {
+	revisions := []*PFSenseConfigRevision{
+		{Time: 300, Description: "admin@192.168.1.10 (API): Added firewall rule"},
+		{Time: 100, Description: "Changed before the run"},
+		{Time: 200, Description: "admin@192.168.1.10 (API): Applied firewall changes"},
+		{Time: 400, Description: "admin@192.168.1.10 (API): Updated firewall rule"},
+	}
+
+	target, reverted := revertedRevisions(revisions, 200)
+	if target == nil || target.Time != 300 {
+		t.Fatalf("expected revision 300 to be restored, got %+v", target)
+	}
+	if want := []string{"admin@192.168.1.10 (API): Updated firewall rule"}; !slices.Equal(reverted, want) {
+		t.Errorf("expected reverted changes %q, got %q", want, reverted)
+	}
+	if target, _ := revertedRevisions(revisions, 400); target != nil {
+		t.Errorf("expected no revision after the newest, got %+v", target)
+	}
+}
@@ -0,0 +1,40 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseSSHSettings is the configuration of the SSH daemon.
+type PFSenseSSHSettings struct {
+	Enabled bool
+	Port    string
+	// KeyOnly is "disabled" to accept a password or a public key, "enabled"
+	// to only accept a public key and "both" to require both.
+	KeyOnly         string
+	AgentForwarding bool
+}
+
+func (c *PFSenseClientV2) GetSSHSettings() (*PFSenseSSHSettings, error) {
+	response, err := c.apiClient.GetServicesSSHEndpointWithResponse(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving SSH settings", response.StatusCode(), response.Body)
+	}
+	return SSHSettingsFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateSSHSettings(settings *PFSenseSSHSettings) (*PFSenseSSHSettings, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PatchServicesSSHEndpointWithResponse(context.Background(), settings.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating SSH settings", response.StatusCode(), response.Body)
+	}
+	return SSHSettingsFromAPI(*response.JSON200.Data), nil
+}
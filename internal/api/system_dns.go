@@ -0,0 +1,46 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseSystemDNS is the device's own DNS client configuration. The DNS
+// resolver also forwards to these servers when forwarding is enabled.
+type PFSenseSystemDNS struct {
+	Servers []PFSenseSystemDNSServer
+	// AllowOverride lets servers learned by DHCP or PPP on WAN interfaces
+	// replace Servers.
+	AllowOverride bool
+}
+
+type PFSenseSystemDNSServer struct {
+	Address string
+	// Gateway is the gateway the server is reached through, or nil to use the
+	// routing table.
+	Gateway *string
+}
+
+func (c *PFSenseClientV2) GetSystemDNS() (*PFSenseSystemDNS, error) {
+	response, err := c.apiClient.GetSystemDNSEndpointWithResponse(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving system DNS", response.StatusCode(), response.Body)
+	}
+	return SystemDNSFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateSystemDNS(dns *PFSenseSystemDNS) (*PFSenseSystemDNS, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PatchSystemDNSEndpointWithResponse(context.Background(), dns.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating system DNS", response.StatusCode(), response.Body)
+	}
+	return SystemDNSFromAPI(*response.JSON200.Data), nil
+}
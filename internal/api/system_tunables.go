@@ -0,0 +1,102 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseSystemTunable sets a sysctl variable when the device boots and when
+// it is saved.
+type PFSenseSystemTunable struct {
+	ID          int
+	Name        string
+	Value       string
+	Description string
+}
+
+func (c *PFSenseClientV2) GetSystemTunables() ([]*PFSenseSystemTunable, error) {
+	limit := 0
+	response, err := c.apiClient.GetSystemTunablesEndpointWithResponse(
+		context.Background(),
+		&GetSystemTunablesEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving system tunables", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseSystemTunable{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, SystemTunableFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetSystemTunable(id int) (*PFSenseSystemTunable, error) {
+	response, err := c.apiClient.GetSystemTunableEndpointWithResponse(
+		context.Background(),
+		&GetSystemTunableEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving system tunable", response.StatusCode(), response.Body)
+	}
+	return SystemTunableFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateSystemTunable(item *PFSenseSystemTunable) (*PFSenseSystemTunable, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostSystemTunableEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating system tunable", response.StatusCode(), response.Body)
+	}
+	return SystemTunableFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateSystemTunable(item *PFSenseSystemTunable) (*PFSenseSystemTunable, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchSystemTunableEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating system tunable", response.StatusCode(), response.Body)
+	}
+	return SystemTunableFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteSystemTunable(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteSystemTunableEndpointWithResponse(
+		context.Background(),
+		&DeleteSystemTunableEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting system tunable", response.StatusCode(), response.Body)
+	}
+	return nil
+}
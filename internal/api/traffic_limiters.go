@@ -0,0 +1,137 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseTrafficLimiter is a dummynet pipe. Rules send traffic through it by
+// naming the limiter, or one of its queues, in their in and out pipes.
+type PFSenseTrafficLimiter struct {
+	ID          int
+	Name        string
+	Enabled     bool
+	Description string
+	Bandwidths  []PFSenseTrafficLimiterBandwidth
+	// Mask is "none", "srcaddress" or "dstaddress". With a mask, every
+	// address gets its own pipe of the full bandwidth.
+	Mask       string
+	MaskBits   *int
+	MaskBitsV6 *int
+	Scheduler  string
+	AQM        string
+	QueueLimit *int
+	ECN        bool
+	// Delay is in milliseconds.
+	Delay  int
+	Queues []PFSenseTrafficLimiterQueue
+}
+
+// PFSenseTrafficLimiterBandwidth is a bandwidth limit, applied while Schedule
+// is active or always when Schedule is nil.
+type PFSenseTrafficLimiterBandwidth struct {
+	Bandwidth int
+	// Scale is "b", "Kb" or "Mb" per second.
+	Scale    string
+	Schedule *string
+}
+
+type PFSenseTrafficLimiterQueue struct {
+	Name        string
+	Enabled     bool
+	Description string
+	Mask        string
+	MaskBits    *int
+	MaskBitsV6  *int
+	Weight      *int
+	AQM         string
+	QueueLimit  *int
+	ECN         bool
+}
+
+func (c *PFSenseClientV2) GetTrafficLimiters() ([]*PFSenseTrafficLimiter, error) {
+	limit := 0
+	response, err := c.apiClient.GetFirewallTrafficShaperLimitersEndpointWithResponse(
+		context.Background(),
+		&GetFirewallTrafficShaperLimitersEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving traffic limiters", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseTrafficLimiter{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, TrafficLimiterFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetTrafficLimiter(id int) (*PFSenseTrafficLimiter, error) {
+	response, err := c.apiClient.GetFirewallTrafficShaperLimiterEndpointWithResponse(
+		context.Background(),
+		&GetFirewallTrafficShaperLimiterEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving traffic limiter", response.StatusCode(), response.Body)
+	}
+	return TrafficLimiterFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateTrafficLimiter(item *PFSenseTrafficLimiter) (*PFSenseTrafficLimiter, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostFirewallTrafficShaperLimiterEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating traffic limiter", response.StatusCode(), response.Body)
+	}
+	return TrafficLimiterFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateTrafficLimiter(item *PFSenseTrafficLimiter) (*PFSenseTrafficLimiter, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchFirewallTrafficShaperLimiterEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating traffic limiter", response.StatusCode(), response.Body)
+	}
+	return TrafficLimiterFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteTrafficLimiter(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteFirewallTrafficShaperLimiterEndpointWithResponse(
+		context.Background(),
+		&DeleteFirewallTrafficShaperLimiterEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting traffic limiter", response.StatusCode(), response.Body)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseTrafficShaper is the ALTQ shaper of one interface, with the queues
+// rules assign traffic to.
+type PFSenseTrafficShaper struct {
+	ID        int
+	Interface string
+	Enabled   bool
+	Scheduler string
+	Bandwidth int
+	// BandwidthType is the unit of Bandwidth: "%", "b", "Kb", "Mb" or "Gb".
+	BandwidthType string
+	QueueLimit    *int
+	TBRSize       *int
+	Queues        []PFSenseTrafficShaperQueue
+}
+
+type PFSenseTrafficShaperQueue struct {
+	Name        string
+	Enabled     bool
+	Description string
+	Priority    *int
+	QueueLimit  *int
+	// Default marks the queue that receives traffic no rule assigns.
+	Default bool
+	// Bandwidth and BandwidthType are nil when the queue has no bandwidth share.
+	Bandwidth     *int
+	BandwidthType *string
+	RED           bool
+	RIO           bool
+	ECN           bool
+	CoDel         bool
+}
+
+func (c *PFSenseClientV2) GetTrafficShapers() ([]*PFSenseTrafficShaper, error) {
+	limit := 0
+	response, err := c.apiClient.GetFirewallTrafficShapersEndpointWithResponse(
+		context.Background(),
+		&GetFirewallTrafficShapersEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving traffic shapers", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseTrafficShaper{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, TrafficShaperFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetTrafficShaper(id int) (*PFSenseTrafficShaper, error) {
+	response, err := c.apiClient.GetFirewallTrafficShaperEndpointWithResponse(
+		context.Background(),
+		&GetFirewallTrafficShaperEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving traffic shaper", response.StatusCode(), response.Body)
+	}
+	return TrafficShaperFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateTrafficShaper(item *PFSenseTrafficShaper) (*PFSenseTrafficShaper, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostFirewallTrafficShaperEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating traffic shaper", response.StatusCode(), response.Body)
+	}
+	return TrafficShaperFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateTrafficShaper(item *PFSenseTrafficShaper) (*PFSenseTrafficShaper, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchFirewallTrafficShaperEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating traffic shaper", response.StatusCode(), response.Body)
+	}
+	return TrafficShaperFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteTrafficShaper(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteFirewallTrafficShaperEndpointWithResponse(
+		context.Background(),
+		&DeleteFirewallTrafficShaperEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting traffic shaper", response.StatusCode(), response.Body)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseUserGroup grants its privileges to each of its members.
+type PFSenseUserGroup struct {
+	ID          int
+	Name        string
+	Description string
+	// Scope is "local" for groups of local users and "remote" for groups
+	// matched against an external authentication server.
+	Scope      string
+	Members    []string
+	Privileges []string
+}
+
+func (c *PFSenseClientV2) GetUserGroups() ([]*PFSenseUserGroup, error) {
+	limit := 0
+	response, err := c.apiClient.GetUserGroupsEndpointWithResponse(
+		context.Background(),
+		&GetUserGroupsEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving user groups", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseUserGroup{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, UserGroupFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetUserGroup(id int) (*PFSenseUserGroup, error) {
+	response, err := c.apiClient.GetUserGroupEndpointWithResponse(
+		context.Background(),
+		&GetUserGroupEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving user group", response.StatusCode(), response.Body)
+	}
+	return UserGroupFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateUserGroup(item *PFSenseUserGroup) (*PFSenseUserGroup, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostUserGroupEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating user group", response.StatusCode(), response.Body)
+	}
+	return UserGroupFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateUserGroup(item *PFSenseUserGroup) (*PFSenseUserGroup, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchUserGroupEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating user group", response.StatusCode(), response.Body)
+	}
+	return UserGroupFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteUserGroup(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteUserGroupEndpointWithResponse(
+		context.Background(),
+		&DeleteUserGroupEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting user group", response.StatusCode(), response.Body)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// PFSenseUser is a local user account.
+type PFSenseUser struct {
+	ID   int
+	Name string
+	// Password is only sent, never read back: pfSense stores a hash of it.
+	// Leaving it empty on update keeps the current password.
+	Password    string
+	Privileges  []string
+	Disabled    bool
+	Description string
+	// Expires is the last day the account can log in, as MM/DD/YYYY, or empty
+	// for no expiry.
+	Expires        string
+	AuthorizedKeys []string
+}
+
+func (c *PFSenseClientV2) GetUsers() ([]*PFSenseUser, error) {
+	limit := 0
+	response, err := c.apiClient.GetUsersEndpointWithResponse(
+		context.Background(),
+		&GetUsersEndpointParams{
+			Limit: &limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, responseError("retrieving users", response.StatusCode(), response.Body)
+	}
+
+	var items = []*PFSenseUser{}
+	for _, item := range sliceOrEmpty(response.JSON200.Data) {
+		items = append(items, UserFromAPI(item))
+	}
+
+	return items, nil
+}
+
+func (c *PFSenseClientV2) GetUser(id int) (*PFSenseUser, error) {
+	response, err := c.apiClient.GetUserEndpointWithResponse(
+		context.Background(),
+		&GetUserEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving user", response.StatusCode(), response.Body)
+	}
+	return UserFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) CreateUser(item *PFSenseUser) (*PFSenseUser, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	response, err := c.apiClient.PostUserEndpointWithResponse(context.Background(), item.ToAPI())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("creating user", response.StatusCode(), response.Body)
+	}
+	return UserFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) UpdateUser(item *PFSenseUser) (*PFSenseUser, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	body := item.ToAPI()
+	body.Id = pointerTo(item.ID)
+	response, err := c.apiClient.PatchUserEndpointWithResponse(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("updating user", response.StatusCode(), response.Body)
+	}
+	return UserFromAPI(*response.JSON200.Data), nil
+}
+
+func (c *PFSenseClientV2) DeleteUser(id int) error {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return err
+	}
+	response, err := c.apiClient.DeleteUserEndpointWithResponse(
+		context.Background(),
+		&DeleteUserEndpointParams{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return responseError("deleting user", response.StatusCode(), response.Body)
+	}
+	return nil
+}
+
+// SetUserGroups makes the user a member of exactly the named groups, updating
+// only the groups whose membership changes. Nothing is changed when a named
+// group does not exist.
+func (c *PFSenseClientV2) SetUserGroups(name string, groups []string) error {
+	all, err := c.GetUserGroups()
+	if err != nil {
+		return err
+	}
+	var unknown []string
+	for _, group := range groups {
+		if !slices.ContainsFunc(all, func(existing *PFSenseUserGroup) bool { return existing.Name == group }) {
+			unknown = append(unknown, group)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("no group named %s", strings.Join(unknown, ", "))
+	}
+
+	for _, group := range all {
+		want := slices.Contains(groups, group.Name)
+		if want == slices.Contains(group.Members, name) {
+			continue
+		}
+		if want {
+			group.Members = append(group.Members, name)
+		} else {
+			group.Members = slices.DeleteFunc(group.Members, func(member string) bool { return member == name })
+		}
+		if _, err := c.UpdateUserGroup(group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UserGroupNames returns the names of the groups the user is a member of.
+func (c *PFSenseClientV2) UserGroupNames(name string) ([]string, error) {
+	all, err := c.GetUserGroups()
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, group := range all {
+		if slices.Contains(group.Members, name) {
+			names = append(names, group.Name)
+		}
+	}
+	return names, nil
+}
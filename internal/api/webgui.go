@@ -0,0 +1,75 @@
+package pfsense_rest_v2
+
+import (
+	"context"
+)
+
+// PFSenseWebGUISettings is how the webGUI, and with it the REST API, is
+// served.
+type PFSenseWebGUISettings struct {
+	// Protocol is "http" or "https".
+	Protocol string
+	// Port is empty for the default port of Protocol.
+	Port string
+	// SSLCertificateRefID is the refid of the certificate served over https.
+	SSLCertificateRefID string
+	// AntiLockout keeps an implicit rule passing webGUI and SSH traffic on
+	// the LAN interface.
+	AntiLockout    bool
+	DNSRebindCheck bool
+	HSTS           bool
+}
+
+func (c *PFSenseClientV2) GetWebGUISettings() (*PFSenseWebGUISettings, error) {
+	response, err := c.apiClient.GetSystemWebGUISettingsEndpointWithResponse(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil || response.JSON200.Data == nil {
+		return nil, responseError("retrieving webGUI settings", response.StatusCode(), response.Body)
+	}
+	return WebGUISettingsFromAPI(*response.JSON200.Data), nil
+}
+
+// UpdateWebGUISettings changes the webGUI settings, guarded by the safety
+// check when it is enabled. When the protocol or port change, the API moves
+// with the webGUI: the client follows it to the new URL and waits for it to
+// answer there before reading the settings back.
+func (c *PFSenseClientV2) UpdateWebGUISettings(settings *PFSenseWebGUISettings) (*PFSenseWebGUISettings, error) {
+	if err := c.snapshotBeforeChange(); err != nil {
+		return nil, err
+	}
+	var updated *PFSenseWebGUISettings
+	err := c.guardApply("webGUI", settings, func() error {
+		var err error
+		updated, err = c.updateWebGUISettings(settings)
+		return err
+	})
+	return updated, err
+}
+
+func (c *PFSenseClientV2) updateWebGUISettings(settings *PFSenseWebGUISettings) (*PFSenseWebGUISettings, error) {
+	current := c.endpoint.Load()
+	moved := movedEndpoint(current, settings.Protocol, settings.Port)
+
+	response, err := c.apiClient.PatchSystemWebGUISettingsEndpointWithResponse(context.Background(), settings.ToAPI())
+	if moved.String() == current.String() {
+		if err != nil {
+			return nil, err
+		}
+		if response.JSON200 == nil || response.JSON200.Data == nil {
+			return nil, responseError("updating webGUI settings", response.StatusCode(), response.Body)
+		}
+		return WebGUISettingsFromAPI(*response.JSON200.Data), nil
+	}
+
+	// The webGUI may restart before the response is complete, so a failed
+	// request is only reported when the API cannot be reached at the new URL.
+	if err == nil && response.JSON200 == nil {
+		return nil, responseError("updating webGUI settings", response.StatusCode(), response.Body)
+	}
+	if err := c.moveEndpoint(moved); err != nil {
+		return nil, err
+	}
+	return c.GetWebGUISettings()
+}
@@ -0,0 +1,316 @@
+// Package generate renders the configuration of an existing pfSense device as
+// Terraform resource and import blocks, so a device configured by hand can be
+// brought under Terraform management without transcribing it.
+package generate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	firewallRuleType      = "pfsense-v2_firewall_rule"
+	floatingRuleType      = "pfsense-v2_firewall_floating_rule"
+	firewallAliasType     = "pfsense-v2_firewall_alias"
+	firewallScheduleType  = "pfsense-v2_firewall_schedule"
+	natPortForwardType    = "pfsense-v2_firewall_nat_port_forward"
+	dhcpStaticMappingType = "pfsense-v2_dhcp_server_static_mapping"
+	interfaceType         = "pfsense-v2_interface"
+)
+
+// Snapshot is the configuration read from a pfSense device.
+type Snapshot struct {
+	Interfaces     []*pfsense_rest_v2.PFSenseInterface
+	Aliases        []*pfsense_rest_v2.PFSenseFirewallAlias
+	Schedules      []*pfsense_rest_v2.PFSenseFirewallSchedule
+	Rules          []*pfsense_rest_v2.PFSenseFirewallRule
+	PortForwards   []*pfsense_rest_v2.PFSenseNATPortForward
+	StaticMappings []*pfsense_rest_v2.PFSenseDHCPStaticMapping
+}
+
+// Read fetches everything Render knows how to emit.
+func Read(client *pfsense_rest_v2.PFSenseClientV2) (*Snapshot, error) {
+	var snapshot Snapshot
+	var err error
+
+	if snapshot.Interfaces, err = client.GetInterfaces(); err != nil {
+		return nil, fmt.Errorf("reading interfaces: %w", err)
+	}
+	if snapshot.Aliases, err = client.GetFirewallAliases(); err != nil {
+		return nil, fmt.Errorf("reading firewall aliases: %w", err)
+	}
+	if snapshot.Schedules, err = client.GetFirewallSchedules(); err != nil {
+		return nil, fmt.Errorf("reading firewall schedules: %w", err)
+	}
+	if snapshot.Rules, err = client.GetFirewallRules(); err != nil {
+		return nil, fmt.Errorf("reading firewall rules: %w", err)
+	}
+	if snapshot.PortForwards, err = client.GetNATPortForwards(); err != nil {
+		return nil, fmt.Errorf("reading NAT port forwards: %w", err)
+	}
+	if snapshot.StaticMappings, err = client.GetDHCPStaticMappings(); err != nil {
+		return nil, fmt.Errorf("reading DHCP static mappings: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// Render emits a resource block and a matching import block for every object in
+// the snapshot. Attributes left at the resource's default are omitted, and
+// import IDs use each object's stable key where it has one, since pfSense IDs
+// shift as objects are removed.
+func Render(snapshot *Snapshot) []byte {
+	file := hclwrite.NewEmptyFile()
+	names := newNamer()
+
+	for _, iface := range snapshot.Interfaces {
+		name := names.name(interfaceType, iface.ID)
+		body := appendResource(file, interfaceType, name, iface.ID)
+		body.SetAttributeValue("port", cty.StringVal(iface.Port))
+		body.SetAttributeValue("description", cty.StringVal(iface.Description))
+		setBoolUnlessDefault(body, "enabled", iface.Enabled, true)
+		setOptionalString(body, "spoof_mac", iface.SpoofMAC)
+		setOptionalInt(body, "mtu", iface.MTU)
+		setBoolUnlessDefault(body, "block_private", iface.BlockPrivate, false)
+		setBoolUnlessDefault(body, "block_bogons", iface.BlockBogons, false)
+		setStringUnlessDefault(body, "ipv4_type", iface.IPv4Type, string(pfsense_rest_v2.NetworkInterfaceTypev4None))
+		setOptionalString(body, "ipv4_address", iface.IPv4Address)
+		setOptionalInt(body, "ipv4_subnet", iface.IPv4Subnet)
+		setOptionalString(body, "ipv4_gateway", iface.IPv4Gateway)
+		setStringUnlessDefault(body, "ipv6_type", iface.IPv6Type, string(pfsense_rest_v2.NetworkInterfaceTypev6None))
+		setOptionalString(body, "ipv6_address", iface.IPv6Address)
+		setOptionalInt(body, "ipv6_subnet", iface.IPv6Subnet)
+		setOptionalString(body, "ipv6_gateway", iface.IPv6Gateway)
+	}
+
+	for _, alias := range snapshot.Aliases {
+		name := names.name(firewallAliasType, alias.Name)
+		body := appendResource(file, firewallAliasType, name, alias.Name)
+		body.SetAttributeValue("name", cty.StringVal(alias.Name))
+		body.SetAttributeValue("type", cty.StringVal(alias.Type))
+		setStringUnlessDefault(body, "description", alias.Description, "")
+		setStrings(body, "addresses", alias.Addresses)
+		setStrings(body, "details", alias.Details)
+	}
+
+	// Rules refer to schedules through the schedule resource, so Terraform
+	// creates the schedule first.
+	scheduleNames := map[string]string{}
+	for _, schedule := range snapshot.Schedules {
+		name := names.name(firewallScheduleType, schedule.Name)
+		scheduleNames[schedule.Name] = name
+		body := appendResource(file, firewallScheduleType, name, schedule.Name)
+		body.SetAttributeValue("name", cty.StringVal(schedule.Name))
+		setStringUnlessDefault(body, "description", schedule.Description, "")
+		var timeRanges []cty.Value
+		for _, r := range schedule.TimeRanges {
+			attrs := map[string]cty.Value{"hours": cty.StringVal(r.Hours)}
+			setIntsAttr(attrs, "weekdays", r.Weekdays)
+			setIntsAttr(attrs, "months", r.Months)
+			setIntsAttr(attrs, "days", r.Days)
+			if r.Description != "" {
+				attrs["description"] = cty.StringVal(r.Description)
+			}
+			timeRanges = append(timeRanges, cty.ObjectVal(attrs))
+		}
+		body.SetAttributeValue("time_ranges", cty.TupleVal(timeRanges))
+	}
+
+	for _, rule := range snapshot.Rules {
+		// A rule created by a port forward is managed through the port
+		// forward, so it is not exported as a resource of its own.
+		if rule.AssociatedRuleID != nil && *rule.AssociatedRuleID != "" {
+			continue
+		}
+		resourceType := firewallRuleType
+		if rule.Floating {
+			resourceType = floatingRuleType
+		}
+		name := names.name(resourceType, rule.Description, "rule_"+strconv.Itoa(rule.Tracker))
+		body := appendResource(file, resourceType, name, "tracker:"+strconv.Itoa(rule.Tracker))
+		body.SetAttributeValue("type", cty.StringVal(rule.Type))
+		setStrings(body, "interfaces", rule.Interfaces)
+		if rule.Floating {
+			setStringUnlessDefault(body, "direction", rule.Direction, string(pfsense_rest_v2.FirewallRuleDirectionAny))
+			setBoolUnlessDefault(body, "quick", rule.Quick, false)
+		}
+		setBoolUnlessDefault(body, "disabled", rule.Disabled, false)
+		setStringUnlessDefault(body, "address_family", rule.AddressFamily, string(pfsense_rest_v2.FirewallRuleIpprotocolInet))
+		setBoolUnlessDefault(body, "log", rule.Log, false)
+		setStringUnlessDefault(body, "description", rule.Description, "")
+		setOptionalString(body, "protocol", rule.Protocol)
+		setStringUnlessDefault(body, "source", rule.Source, "any")
+		setOptionalString(body, "source_port", rule.SourcePort)
+		setStringUnlessDefault(body, "destination", rule.Destination, "any")
+		setOptionalString(body, "destination_port", rule.DestinationPort)
+		if rule.Schedule != nil {
+			if scheduleName, ok := scheduleNames[*rule.Schedule]; ok {
+				body.SetAttributeTraversal("sched", hcl.Traversal{
+					hcl.TraverseRoot{Name: firewallScheduleType},
+					hcl.TraverseAttr{Name: scheduleName},
+					hcl.TraverseAttr{Name: "name"},
+				})
+			} else {
+				body.SetAttributeValue("sched", cty.StringVal(*rule.Schedule))
+			}
+		}
+		setOptionalString(body, "dnpipe", rule.InPipe)
+		setOptionalString(body, "pdnpipe", rule.OutPipe)
+		setOptionalString(body, "defaultqueue", rule.DefaultQueue)
+		setOptionalString(body, "ackqueue", rule.AckQueue)
+		setOptionalString(body, "gateway", rule.Gateway)
+		setStrings(body, "icmptype", rule.ICMPTypes)
+		setStringUnlessDefault(body, "statetype", rule.StateType, string(pfsense_rest_v2.FirewallRuleStatetypeKeepState))
+		setBoolUnlessDefault(body, "tcp_flags_any", rule.TCPFlagsAny, false)
+		setStrings(body, "tcp_flags_out_of", rule.TCPFlagsOutOf)
+		setStrings(body, "tcp_flags_set", rule.TCPFlagsSet)
+	}
+
+	for _, pf := range snapshot.PortForwards {
+		// Port forwards have no stable key, so they are imported by pfSense ID.
+		name := names.name(natPortForwardType, pf.Description, "port_forward_"+strconv.Itoa(pf.ID))
+		body := appendResource(file, natPortForwardType, name, strconv.Itoa(pf.ID))
+		body.SetAttributeValue("interface", cty.StringVal(pf.Interface))
+		setStringUnlessDefault(body, "address_family", pf.AddressFamily, string(pfsense_rest_v2.PortForwardIpprotocolInet))
+		body.SetAttributeValue("protocol", cty.StringVal(pf.Protocol))
+		setStringUnlessDefault(body, "source", pf.Source, "any")
+		setOptionalString(body, "source_port", pf.SourcePort)
+		body.SetAttributeValue("destination", cty.StringVal(pf.Destination))
+		setOptionalString(body, "destination_port", pf.DestinationPort)
+		body.SetAttributeValue("target", cty.StringVal(pf.Target))
+		setOptionalString(body, "local_port", pf.LocalPort)
+		setBoolUnlessDefault(body, "disabled", pf.Disabled, false)
+		setBoolUnlessDefault(body, "no_rdr", pf.NoRDR, false)
+		setBoolUnlessDefault(body, "no_sync", pf.NoSync, false)
+		setStringUnlessDefault(body, "description", pf.Description, "")
+		setOptionalString(body, "nat_reflection", pf.NATReflection)
+	}
+
+	for _, mapping := range snapshot.StaticMappings {
+		hostname := ""
+		if mapping.Hostname != nil {
+			hostname = *mapping.Hostname
+		}
+		name := names.name(dhcpStaticMappingType, hostname, mapping.Interface+"_"+mapping.MACAddress)
+		body := appendResource(file, dhcpStaticMappingType, name, mapping.Interface+"/"+mapping.MACAddress)
+		body.SetAttributeValue("interface", cty.StringVal(mapping.Interface))
+		body.SetAttributeValue("mac_address", cty.StringVal(mapping.MACAddress))
+		setOptionalString(body, "ip_address", mapping.IPAddress)
+		setOptionalString(body, "client_id", mapping.ClientID)
+		setOptionalString(body, "hostname", mapping.Hostname)
+		setOptionalString(body, "domain", mapping.Domain)
+		setStringUnlessDefault(body, "description", mapping.Description, "")
+		setBoolUnlessDefault(body, "arp_table_static_entry", mapping.ARPTableStaticEntry, false)
+	}
+
+	return file.Bytes()
+}
+
+// appendResource adds an import block and an empty resource block for it,
+// returning the resource body for the caller to fill in.
+func appendResource(file *hclwrite.File, resourceType string, name string, importID string) *hclwrite.Body {
+	root := file.Body()
+	if len(root.Blocks()) > 0 {
+		root.AppendNewline()
+	}
+
+	importBody := root.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(importID))
+	root.AppendNewline()
+
+	return root.AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+func setStringUnlessDefault(body *hclwrite.Body, name string, value string, def string) {
+	if value != def {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
+
+func setBoolUnlessDefault(body *hclwrite.Body, name string, value bool, def bool) {
+	if value != def {
+		body.SetAttributeValue(name, cty.BoolVal(value))
+	}
+}
+
+func setOptionalString(body *hclwrite.Body, name string, value *string) {
+	if value != nil {
+		body.SetAttributeValue(name, cty.StringVal(*value))
+	}
+}
+
+func setOptionalInt(body *hclwrite.Body, name string, value *int) {
+	if value != nil {
+		body.SetAttributeValue(name, cty.NumberIntVal(int64(*value)))
+	}
+}
+
+func setStrings(body *hclwrite.Body, name string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	var elems []cty.Value
+	for _, v := range values {
+		elems = append(elems, cty.StringVal(v))
+	}
+	body.SetAttributeValue(name, cty.ListVal(elems))
+}
+
+// setIntsAttr adds a list of numbers to an object's attributes unless it is empty.
+func setIntsAttr(attrs map[string]cty.Value, name string, values []int) {
+	if len(values) == 0 {
+		return
+	}
+	var elems []cty.Value
+	for _, v := range values {
+		elems = append(elems, cty.NumberIntVal(int64(v)))
+	}
+	attrs[name] = cty.ListVal(elems)
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// namer turns descriptions into Terraform resource names that are valid and
+// unique per resource type.
+type namer struct {
+	used map[string]bool
+}
+
+func newNamer() *namer {
+	return &namer{used: map[string]bool{}}
+}
+
+// name returns a resource name built from the first candidate that produces
+// a non-empty identifier, adding a numeric suffix if that name is taken.
+func (n *namer) name(resourceType string, candidates ...string) string {
+	base := "unnamed"
+	for _, candidate := range candidates {
+		sanitized := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(candidate), "_"), "_")
+		if sanitized != "" {
+			base = sanitized
+			break
+		}
+	}
+	// Resource names must start with a letter or underscore.
+	if base[0] >= '0' && base[0] <= '9' {
+		base = "_" + base
+	}
+
+	name := base
+	for i := 2; n.used[resourceType+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	n.used[resourceType+"."+name] = true
+	return name
+}
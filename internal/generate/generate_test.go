@@ -0,0 +1,201 @@
+package generate
+
+import (
+	"strings"
+	"testing"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+)
+
+func TestRender(t *testing.T) {
+	port := "443"
+	protocol := "tcp"
+	snapshot := &Snapshot{
+		Rules: []*pfsense_rest_v2.PFSenseFirewallRule{
+			{
+				ID:              3,
+				Tracker:         1700000000,
+				Type:            "pass",
+				Interfaces:      []string{"lan"},
+				AddressFamily:   "inet",
+				Description:     "Allow HTTPS",
+				Protocol:        &protocol,
+				Source:          "any",
+				Destination:     "any",
+				DestinationPort: &port,
+			},
+			{
+				ID:            4,
+				Tracker:       1700000001,
+				Type:          "block",
+				Interfaces:    []string{"wan"},
+				AddressFamily: "inet",
+				Description:   "Allow HTTPS",
+				Source:        "any",
+				Destination:   "any",
+			},
+		},
+	}
+
+	got := string(Render(snapshot))
+
+	for _, want := range []string{
+		`to = pfsense-v2_firewall_rule.allow_https`,
+		`id = "tracker:1700000000"`,
+		`resource "pfsense-v2_firewall_rule" "allow_https"`,
+		`destination_port = "443"`,
+		// Duplicate descriptions get a numeric suffix.
+		`resource "pfsense-v2_firewall_rule" "allow_https_2"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+	// Defaults are omitted.
+	if strings.Contains(got, `source =`) || strings.Contains(got, `address_family`) {
+		t.Errorf("expected default attributes to be omitted, got:\n%s", got)
+	}
+}
+
+func TestRender_FloatingRules(t *testing.T) {
+	snapshot := &Snapshot{
+		Rules: []*pfsense_rest_v2.PFSenseFirewallRule{
+			{
+				ID:            0,
+				Tracker:       1700000002,
+				Type:          "block",
+				Interfaces:    []string{"wan", "opt1"},
+				AddressFamily: "inet",
+				Description:   "Block bogons",
+				Source:        "bogons",
+				Destination:   "any",
+				StateType:     "keep state",
+				ICMPTypes:     []string{},
+				Floating:      true,
+				Direction:     "any",
+				Quick:         true,
+			},
+		},
+	}
+
+	got := string(Render(snapshot))
+
+	for _, want := range []string{
+		`to = pfsense-v2_firewall_floating_rule.block_bogons`,
+		`resource "pfsense-v2_firewall_floating_rule" "block_bogons"`,
+		`interfaces  = ["wan", "opt1"]`,
+		`quick       = true`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, `direction`) || strings.Contains(got, `statetype`) || strings.Contains(got, `icmptype`) {
+		t.Errorf("expected default attributes to be omitted, got:\n%s", got)
+	}
+}
+
+func TestRender_Schedules(t *testing.T) {
+	schedule := "business_hours"
+	snapshot := &Snapshot{
+		Schedules: []*pfsense_rest_v2.PFSenseFirewallSchedule{
+			{
+				ID:   0,
+				Name: schedule,
+				TimeRanges: []pfsense_rest_v2.PFSenseFirewallScheduleTimeRange{
+					{Weekdays: []int{1, 2, 3, 4, 5}, Hours: "8:00-18:00"},
+					{Months: []int{12}, Days: []int{24}, Hours: "8:00-12:00", Description: "Christmas Eve"},
+				},
+			},
+		},
+		Rules: []*pfsense_rest_v2.PFSenseFirewallRule{
+			{
+				Tracker:       1700000000,
+				Type:          "pass",
+				Interfaces:    []string{"opt1"},
+				AddressFamily: "inet",
+				Description:   "Guest Wi-Fi",
+				Source:        "any",
+				Destination:   "any",
+				Schedule:      &schedule,
+			},
+		},
+	}
+
+	got := string(Render(snapshot))
+
+	for _, want := range []string{
+		`resource "pfsense-v2_firewall_schedule" "business_hours"`,
+		`id = "business_hours"`,
+		`weekdays = [1, 2, 3, 4, 5]`,
+		`months      = [12]`,
+		`description = "Christmas Eve"`,
+		`sched       = pfsense-v2_firewall_schedule.business_hours.name`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestNamer(t *testing.T) {
+	names := newNamer()
+	cases := []struct {
+		candidates []string
+		want       string
+	}{
+		{[]string{"Allow DNS (UDP)"}, "allow_dns_udp"},
+		{[]string{"", "rule_12"}, "rule_12"},
+		{[]string{"10.0.0.1 access"}, "_10_0_0_1_access"},
+		{[]string{"Allow DNS (UDP)"}, "allow_dns_udp_2"},
+	}
+	for _, c := range cases {
+		if got := names.name("test", c.candidates...); got != c.want {
+			t.Errorf("name(%q) = %q, want %q", c.candidates, got, c.want)
+		}
+	}
+}
+
+func TestRender_PortForwardRules(t *testing.T) {
+	port := "443"
+	associated := "nat_6500000000a1b2c3"
+	snapshot := &Snapshot{
+		Rules: []*pfsense_rest_v2.PFSenseFirewallRule{
+			{
+				Tracker:          1700000003,
+				Type:             "pass",
+				Interfaces:       []string{"wan"},
+				AddressFamily:    "inet",
+				Description:      "NAT Web server",
+				Source:           "any",
+				Destination:      "192.168.1.10",
+				DestinationPort:  &port,
+				AssociatedRuleID: &associated,
+			},
+		},
+		PortForwards: []*pfsense_rest_v2.PFSenseNATPortForward{
+			{
+				ID:               0,
+				Interface:        "wan",
+				AddressFamily:    "inet",
+				Protocol:         "tcp",
+				Source:           "any",
+				Destination:      "wan:ip",
+				DestinationPort:  &port,
+				Target:           "192.168.1.10",
+				LocalPort:        &port,
+				Description:      "Web server",
+				AssociatedRuleID: &associated,
+			},
+		},
+	}
+
+	got := string(Render(snapshot))
+
+	if !strings.Contains(got, `resource "pfsense-v2_firewall_nat_port_forward" "web_server"`) {
+		t.Errorf("expected the port forward to be exported, got:\n%s", got)
+	}
+	if strings.Contains(got, `pfsense-v2_firewall_rule`) {
+		t.Errorf("expected the port forward's rule not to be exported on its own, got:\n%s", got)
+	}
+}
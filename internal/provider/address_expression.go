@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+// Address expression kinds returned by ParseAddressExpression.
+const (
+	AddressKindAny       = "any"
+	AddressKindSelf      = "self"
+	AddressKindL2TP      = "l2tp"
+	AddressKindPPPoE     = "pppoe"
+	AddressKindAddress   = "address"
+	AddressKindNetwork   = "network"
+	AddressKindInterface = "interface"
+	AddressKindAlias     = "alias"
+)
+
+// AddressExpression is a parsed rule source or destination. Value holds the
+// address, network, interface or alias name without the `!` prefix or the
+// `:ip` modifier.
+type AddressExpression struct {
+	Kind        string
+	Value       string
+	Inverted    bool
+	InterfaceIP bool
+}
+
+var (
+	addressKeywords = map[string]string{
+		"any":    AddressKindAny,
+		"(self)": AddressKindSelf,
+		"l2tp":   AddressKindL2TP,
+		"pppoe":  AddressKindPPPoE,
+	}
+
+	// Alias and interface names share the same character set; pfSense's own
+	// interface identifiers are told apart by their fixed naming scheme.
+	addressNamePattern      = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	interfaceIdentifierName = regexp.MustCompile(`^(wan|lan|opt[0-9]+)$`)
+)
+
+// ParseAddressExpression parses pfSense's address grammar for rule and NAT
+// sources and destinations.
+func ParseAddressExpression(val string) (AddressExpression, error) {
+	expr := AddressExpression{Value: val}
+	if rest, ok := strings.CutPrefix(val, "!"); ok {
+		expr.Inverted = true
+		expr.Value = rest
+	}
+
+	if kind, ok := addressKeywords[expr.Value]; ok {
+		if expr.Inverted && kind == AddressKindAny {
+			return AddressExpression{}, fmt.Errorf("`any` cannot be inverted")
+		}
+		expr.Kind = kind
+		return expr, nil
+	}
+
+	if _, err := netip.ParseAddr(expr.Value); err == nil {
+		expr.Kind = AddressKindAddress
+		return expr, nil
+	}
+	if _, err := netip.ParsePrefix(expr.Value); err == nil {
+		expr.Kind = AddressKindNetwork
+		return expr, nil
+	}
+
+	if name, modifier, ok := strings.Cut(expr.Value, ":"); ok {
+		if modifier != "ip" {
+			return AddressExpression{}, fmt.Errorf("unknown address modifier %q in %s, only `:ip` is supported", ":"+modifier, val)
+		}
+		if !addressNamePattern.MatchString(name) {
+			return AddressExpression{}, fmt.Errorf("invalid interface name %q in %s", name, val)
+		}
+		expr.Kind = AddressKindInterface
+		expr.Value = name
+		expr.InterfaceIP = true
+		return expr, nil
+	}
+
+	if strings.Contains(expr.Value, "/") {
+		return AddressExpression{}, fmt.Errorf("invalid subnet CIDR: %s", val)
+	}
+	if !addressNamePattern.MatchString(expr.Value) {
+		return AddressExpression{}, fmt.Errorf("invalid address value: %s", val)
+	}
+	if interfaceIdentifierName.MatchString(expr.Value) {
+		expr.Kind = AddressKindInterface
+	} else {
+		expr.Kind = AddressKindAlias
+	}
+	return expr, nil
+}
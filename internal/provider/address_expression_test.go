@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseAddressExpression(t *testing.T) {
+	cases := []struct {
+		value string
+		want  AddressExpression
+	}{
+		{"any", AddressExpression{Kind: AddressKindAny, Value: "any"}},
+		{"(self)", AddressExpression{Kind: AddressKindSelf, Value: "(self)"}},
+		{"!l2tp", AddressExpression{Kind: AddressKindL2TP, Value: "l2tp", Inverted: true}},
+		{"pppoe", AddressExpression{Kind: AddressKindPPPoE, Value: "pppoe"}},
+		{"192.168.1.10", AddressExpression{Kind: AddressKindAddress, Value: "192.168.1.10"}},
+		{"fd00::1", AddressExpression{Kind: AddressKindAddress, Value: "fd00::1"}},
+		{"!10.0.0.0/8", AddressExpression{Kind: AddressKindNetwork, Value: "10.0.0.0/8", Inverted: true}},
+		{"fd00::/64", AddressExpression{Kind: AddressKindNetwork, Value: "fd00::/64"}},
+		{"lan", AddressExpression{Kind: AddressKindInterface, Value: "lan"}},
+		{"opt2:ip", AddressExpression{Kind: AddressKindInterface, Value: "opt2", InterfaceIP: true}},
+		{"!wan:ip", AddressExpression{Kind: AddressKindInterface, Value: "wan", Inverted: true, InterfaceIP: true}},
+		{"web_servers", AddressExpression{Kind: AddressKindAlias, Value: "web_servers"}},
+	}
+	for _, c := range cases {
+		got, err := ParseAddressExpression(c.value)
+		if err != nil || got != c.want {
+			t.Errorf("ParseAddressExpression(%q) = %+v, %v, want %+v", c.value, got, err, c.want)
+		}
+	}
+
+	for _, value := range []string{"", "!", "!any", "lan:ipp", "lan:", ":ip", "10.0.0.0/33", "web-servers", "!!lan"} {
+		if _, err := ParseAddressExpression(value); err == nil {
+			t.Errorf("ParseAddressExpression(%q) succeeded, want error", value)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AddressExpressionValidator validates that a string is a pfSense address expression.
+
+type AddressExpressionValidator struct{}
+
+func (v AddressExpressionValidator) Description(ctx context.Context) string {
+	return "Valid value options are: an existing interface, an IP address, a subnet CIDR, an existing alias, `any`, `(self)`, `l2tp`, `pppoe`, optionally prefixed with `!`. Interface values may carry the `:ip` modifier."
+}
+
+func (v AddressExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v AddressExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := ParseAddressExpression(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(
+			diag.NewAttributeErrorDiagnostic(
+				req.Path,
+				"Invalid address value",
+				err.Error()+". "+v.Description(ctx),
+			),
+		)
+	}
+}
@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertificateAuthorityResource{}
+var _ resource.ResourceWithImportState = &CertificateAuthorityResource{}
+var _ resource.ResourceWithValidateConfig = &CertificateAuthorityResource{}
+
+// certificateAuthorityLifetime is the default validity of a generated CA in days.
+const certificateAuthorityLifetime = 3650
+
+func NewCertificateAuthorityResource() resource.Resource {
+	return &CertificateAuthorityResource{}
+}
+
+// CertificateAuthorityResource defines the resource implementation.
+type CertificateAuthorityResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// CertificateAuthorityResourceModel describes the resource data model.
+type CertificateAuthorityResourceModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	RefID               types.String `tfsdk:"refid"`
+	Method              types.String `tfsdk:"method"`
+	Description         types.String `tfsdk:"description"`
+	Trust               types.Bool   `tfsdk:"trust"`
+	RandomSerial        types.Bool   `tfsdk:"random_serial"`
+	Certificate         types.String `tfsdk:"certificate"`
+	PrivateKeyWO        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
+	CARefID             types.String `tfsdk:"ca_refid"`
+	CertificateRequestModel
+}
+
+// toDomain builds the CA without a private key; callers add it only when it
+// has to be sent.
+func (m *CertificateAuthorityResourceModel) toDomain() *pfsense_rest_v2.PFSenseCertificateAuthority {
+	return &pfsense_rest_v2.PFSenseCertificateAuthority{
+		ID:           int(m.ID.ValueInt64()),
+		RefID:        m.RefID.ValueString(),
+		Description:  m.Description.ValueString(),
+		Trust:        m.Trust.ValueBool(),
+		RandomSerial: m.RandomSerial.ValueBool(),
+		Certificate:  m.Certificate.ValueString(),
+		CARefID:      m.CARefID.ValueString(),
+	}
+}
+
+// fromDomain copies the CA into the model. The private key and the
+// parameters it was generated with cannot be read back, so those attributes
+// are left as they are.
+func (m *CertificateAuthorityResourceModel) fromDomain(ca *pfsense_rest_v2.PFSenseCertificateAuthority) {
+	m.ID = types.Int64Value(int64(ca.ID))
+	m.RefID = types.StringValue(ca.RefID)
+	m.Description = types.StringValue(ca.Description)
+	m.Trust = types.BoolValue(ca.Trust)
+	m.RandomSerial = types.BoolValue(ca.RandomSerial)
+	m.Certificate = pemValue(m.Certificate, ca.Certificate)
+	m.CARefID = types.StringValue(ca.CARefID)
+}
+
+func (r *CertificateAuthorityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_authority"
+}
+
+func (r *CertificateAuthorityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "pfSense ID of the CA. This is the CA's position in the configuration and may change when other CAs are removed; the provider locates the CA by `refid` and records the new ID.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"refid": schema.StringAttribute{
+			MarkdownDescription: "Reference ID of the CA, used by OpenVPN, IPsec, certificates and other CAs to refer to it",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"method": schema.StringAttribute{
+			MarkdownDescription: "How the CA is created: `existing` imports the PEM `certificate` (and optionally its private key), `internal` generates a new key and self-signed or intermediate certificate on the device. Changing it replaces the CA.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(certificateMethodExisting, certificateMethodInternal),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Descriptive name of the CA",
+			Required:            true,
+		},
+		"trust": schema.BoolAttribute{
+			MarkdownDescription: "Whether the CA is added to the operating system trust store",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"random_serial": schema.BoolAttribute{
+			MarkdownDescription: "Whether certificates signed by the CA get random serial numbers instead of sequential ones",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"certificate": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded CA certificate. Required when `method` is `existing`; generated on the device when `method` is `internal`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ca_refid": schema.StringAttribute{
+			MarkdownDescription: "Reference ID of the CA that signs this one. Setting it when `method` is `internal` creates an intermediate CA; for imported CAs it is the issuer found on the device. Changing it replaces the CA.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+	for name, attribute := range writeOnlyAttributes("private_key", "PEM encoded private key of an `existing` CA") {
+		attributes[name] = attribute
+	}
+	for name, attribute := range certificateRequestAttributes(certificateAuthorityLifetime) {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Certificate authority, imported from PEM or generated on the device. Can be imported by pfSense ID or by refid; import as `method = \"existing\"`, since the parameters a CA was generated with cannot be read back.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *CertificateAuthorityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CertificateAuthorityResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateCertificateMethod(&resp.Diagnostics, data.Method, &data.CertificateRequestModel, data.Certificate, data.PrivateKeyWO)
+
+	if data.Method.ValueString() == certificateMethodExisting && !data.CARefID.IsNull() && !data.CARefID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("ca_refid"), "Invalid Attribute Combination",
+			"`ca_refid` can only be set when `method` is `internal`; the issuer of an existing CA is found from its certificate.")
+	}
+}
+
+func (r *CertificateAuthorityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertificateAuthorityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateAuthorityResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ca := data.toDomain()
+	var err error
+	if data.Method.ValueString() == certificateMethodInternal {
+		request := data.CertificateRequestModel.toDomain(certificateAuthorityLifetime)
+		request.Intermediate = ca.CARefID != ""
+		ca, err = r.client.GenerateCertificateAuthority(ca, request)
+	} else {
+		ca.PrivateKey = writeOnlyValue(ctx, req.Config, "private_key", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ca, err = r.client.CreateCertificateAuthority(ca)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create certificate authority, got error: %s", err))
+		return
+	}
+	data.fromDomain(ca)
+
+	tflog.Trace(ctx, "created a certificate authority", map[string]interface{}{"id": ca.ID, "refid": ca.RefID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateAuthorityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateAuthorityResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ca, drift, err := r.client.ResolveCertificateAuthority(int(data.ID.ValueInt64()), data.RefID.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read certificate authority, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(ca)
+
+	// An imported CA can only be described by its certificate
+	if data.Method.IsNull() {
+		data.Method = types.StringValue(certificateMethodExisting)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateAuthorityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CertificateAuthorityResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "certificate authority", func() (*pfsense_rest_v2.PFSenseCertificateAuthority, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveCertificateAuthority(int(state.ID.ValueInt64()), state.RefID.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	// The key is only sent when its version changed, since it cannot be compared with the device
+	ca := data.toDomain()
+	if writeOnlyVersionChanged(data.PrivateKeyWOVersion, state.PrivateKeyWOVersion) {
+		ca.PrivateKey = writeOnlyValue(ctx, req.Config, "private_key", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ca, err := r.client.UpdateCertificateAuthority(ca)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update certificate authority, got error: %s", err))
+		return
+	}
+	data.fromDomain(ca)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateAuthorityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertificateAuthorityResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "certificate authority", func() (*pfsense_rest_v2.PFSenseCertificateAuthority, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveCertificateAuthority(int(data.ID.ValueInt64()), data.RefID.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteCertificateAuthority(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete certificate authority, got error: %s", err))
+	}
+}
+
+func (r *CertificateAuthorityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Reference IDs are hexadecimal and may happen to be all digits, so they
+	// can always be given as refid:<refid>; any other value that is not a
+	// number is taken as a refid too.
+	key, value := importKey(req.ID, "refid")
+	id, err := strconv.Atoi(value)
+	if key != "" || err != nil {
+		cas, err := r.client.GetCertificateAuthorities()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read certificate authorities, got error: %s", err))
+			return
+		}
+		ca, err := findUnique(cas, func(ca *pfsense_rest_v2.PFSenseCertificateAuthority) bool {
+			return ca.RefID == value
+		}, fmt.Sprintf("refid %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Certificate Authority", err.Error())
+			return
+		}
+		id = ca.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	certificateMethodExisting = "existing"
+	certificateMethodInternal = "internal"
+	certificateMethodCSR      = "csr"
+)
+
+// CertificateRequestModel describes the key and subject of a CA or
+// certificate generated on the device. The attributes have no schema
+// defaults so that an imported CA or certificate, whose key parameters
+// cannot be read back, does not plan a replacement; the defaults are
+// applied in toDomain instead.
+type CertificateRequestModel struct {
+	CommonName         types.String `tfsdk:"common_name"`
+	Country            types.String `tfsdk:"country"`
+	State              types.String `tfsdk:"state"`
+	City               types.String `tfsdk:"city"`
+	Organization       types.String `tfsdk:"organization"`
+	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
+	KeyType            types.String `tfsdk:"key_type"`
+	KeyLength          types.Int64  `tfsdk:"key_length"`
+	Curve              types.String `tfsdk:"curve"`
+	DigestAlgorithm    types.String `tfsdk:"digest_algorithm"`
+	LifetimeDays       types.Int64  `tfsdk:"lifetime_days"`
+}
+
+func (m *CertificateRequestModel) toDomain(defaultLifetime int) *pfsense_rest_v2.PFSenseCertificateRequest {
+	return &pfsense_rest_v2.PFSenseCertificateRequest{
+		KeyType:         stringOr(m.KeyType, "RSA"),
+		KeyLength:       int64Or(m.KeyLength, 2048),
+		Curve:           stringOr(m.Curve, "prime256v1"),
+		DigestAlgorithm: stringOr(m.DigestAlgorithm, "sha256"),
+		LifetimeDays:    int64Or(m.LifetimeDays, defaultLifetime),
+		Subject: pfsense_rest_v2.PFSenseCertificateSubject{
+			CommonName:         m.CommonName.ValueString(),
+			Country:            m.Country.ValueString(),
+			State:              m.State.ValueString(),
+			City:               m.City.ValueString(),
+			Organization:       m.Organization.ValueString(),
+			OrganizationalUnit: m.OrganizationalUnit.ValueString(),
+		},
+	}
+}
+
+// isEmpty reports whether none of the request attributes is configured.
+func (m *CertificateRequestModel) isEmpty() bool {
+	for _, value := range []interface{ IsNull() bool }{
+		m.CommonName, m.Country, m.State, m.City, m.Organization, m.OrganizationalUnit,
+		m.KeyType, m.KeyLength, m.Curve, m.DigestAlgorithm, m.LifetimeDays,
+	} {
+		if !value.IsNull() {
+			return false
+		}
+	}
+	return true
+}
+
+// certificateRequestAttributes returns the key and subject attributes shared
+// by CAs and certificates generated on the device. Changing any of them
+// generates a new key, so they all replace the resource.
+func certificateRequestAttributes(defaultLifetime int) map[string]schema.Attribute {
+	subject := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		}
+	}
+	return map[string]schema.Attribute{
+		"common_name": subject("Subject common name. Required unless `method` is `existing`."),
+		"country": schema.StringAttribute{
+			MarkdownDescription: "Subject two-letter country code",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:          []validator.String{stringvalidator.LengthBetween(2, 2)},
+		},
+		"state":               subject("Subject state or province"),
+		"city":                subject("Subject city"),
+		"organization":        subject("Subject organization"),
+		"organizational_unit": subject("Subject organizational unit"),
+		"key_type": schema.StringAttribute{
+			MarkdownDescription: "Key type, `RSA` or `ECDSA`. Defaults to `RSA`.",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:          []validator.String{stringvalidator.OneOf("RSA", "ECDSA")},
+		},
+		"key_length": schema.Int64Attribute{
+			MarkdownDescription: "RSA key length in bits. Defaults to 2048.",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			Validators:          []validator.Int64{int64validator.OneOf(1024, 2048, 3072, 4096, 6144, 7680, 8192, 15360, 16384)},
+		},
+		"curve": schema.StringAttribute{
+			MarkdownDescription: "ECDSA curve, e.g. `prime256v1` or `secp384r1`. Defaults to `prime256v1`.",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		"digest_algorithm": schema.StringAttribute{
+			MarkdownDescription: "Signature digest algorithm. Defaults to `sha256`.",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:          []validator.String{stringvalidator.OneOf("sha1", "sha224", "sha256", "sha384", "sha512")},
+		},
+		"lifetime_days": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Validity period in days. Defaults to %d.", defaultLifetime),
+			Optional:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			Validators:          []validator.Int64{int64validator.Between(1, 12000)},
+		},
+	}
+}
+
+// validateCertificateMethod checks the attributes that depend on `method`:
+// existing objects take a PEM certificate and no request attributes, objects
+// generated on the device take a common name and no PEM certificate or key.
+func validateCertificateMethod(diags *diag.Diagnostics, method types.String, request *CertificateRequestModel, certificate types.String, privateKey types.String) {
+	if method.IsUnknown() || method.IsNull() {
+		return
+	}
+	switch method.ValueString() {
+	case certificateMethodExisting:
+		if certificate.IsNull() {
+			diags.AddAttributeError(path.Root("certificate"), "Missing Attribute", "`certificate` is required when `method` is `existing`.")
+		}
+		if !request.isEmpty() {
+			diags.AddAttributeError(path.Root("method"), "Invalid Attribute Combination",
+				"Subject and key attributes are only used when the key is generated on the device; they cannot be set when `method` is `existing`.")
+		}
+	default:
+		if request.CommonName.IsNull() {
+			diags.AddAttributeError(path.Root("common_name"), "Missing Attribute",
+				fmt.Sprintf("`common_name` is required when `method` is `%s`.", method.ValueString()))
+		}
+		if !privateKey.IsNull() {
+			diags.AddAttributeError(path.Root("private_key_wo"), "Invalid Attribute Combination",
+				"`private_key_wo` can only be set when `method` is `existing`; other methods generate the key on the device.")
+		}
+		if !certificate.IsNull() && method.ValueString() == certificateMethodInternal {
+			diags.AddAttributeError(path.Root("certificate"), "Invalid Attribute Combination",
+				"`certificate` is generated on the device when `method` is `internal` and cannot be set.")
+		}
+	}
+}
+
+// pemValue returns the PEM read from the device, keeping the current value
+// when the two only differ in surrounding whitespace so a configured PEM
+// without a trailing newline does not show a diff.
+func pemValue(current types.String, pem string) types.String {
+	if !current.IsNull() && !current.IsUnknown() && strings.TrimSpace(current.ValueString()) == strings.TrimSpace(pem) {
+		return current
+	}
+	return types.StringValue(pem)
+}
@@ -0,0 +1,441 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertificateResource{}
+var _ resource.ResourceWithImportState = &CertificateResource{}
+var _ resource.ResourceWithValidateConfig = &CertificateResource{}
+var _ resource.ResourceWithModifyPlan = &CertificateResource{}
+
+// certificateLifetime is the default validity of a generated certificate in
+// days, the longest browsers accept for server certificates.
+const certificateLifetime = 398
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+// CertificateResource defines the resource implementation.
+type CertificateResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// CertificateResourceModel describes the resource data model.
+type CertificateResourceModel struct {
+	ID                  types.Int64    `tfsdk:"id"`
+	RefID               types.String   `tfsdk:"refid"`
+	Method              types.String   `tfsdk:"method"`
+	Description         types.String   `tfsdk:"description"`
+	Type                types.String   `tfsdk:"type"`
+	Certificate         types.String   `tfsdk:"certificate"`
+	CSR                 types.String   `tfsdk:"csr"`
+	PrivateKeyWO        types.String   `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64    `tfsdk:"private_key_wo_version"`
+	CARefID             types.String   `tfsdk:"ca_refid"`
+	DNSNames            []types.String `tfsdk:"dns_names"`
+	IPAddresses         []types.String `tfsdk:"ip_addresses"`
+	CertificateRequestModel
+}
+
+// toDomain builds the certificate without a private key; callers add it only
+// when it has to be sent.
+func (m *CertificateResourceModel) toDomain() *pfsense_rest_v2.PFSenseCertificate {
+	return &pfsense_rest_v2.PFSenseCertificate{
+		ID:          int(m.ID.ValueInt64()),
+		RefID:       m.RefID.ValueString(),
+		Description: m.Description.ValueString(),
+		Type:        m.Type.ValueString(),
+		Certificate: m.Certificate.ValueString(),
+		CARefID:     m.CARefID.ValueString(),
+	}
+}
+
+func (m *CertificateResourceModel) requestToDomain() *pfsense_rest_v2.PFSenseCertificateRequest {
+	request := m.CertificateRequestModel.toDomain(certificateLifetime)
+	request.DNSNames = stringsFromValues(m.DNSNames)
+	request.IPAddresses = stringsFromValues(m.IPAddresses)
+	return request
+}
+
+// fromDomain copies the certificate into the model. The private key and the
+// parameters it was generated with cannot be read back, so those attributes
+// are left as they are.
+func (m *CertificateResourceModel) fromDomain(certificate *pfsense_rest_v2.PFSenseCertificate) {
+	m.ID = types.Int64Value(int64(certificate.ID))
+	m.RefID = types.StringValue(certificate.RefID)
+	m.Description = types.StringValue(certificate.Description)
+	m.Type = types.StringValue(certificate.Type)
+	m.Certificate = pemValue(m.Certificate, certificate.Certificate)
+	m.CSR = types.StringValue(certificate.CSR)
+	m.CARefID = types.StringValue(certificate.CARefID)
+}
+
+func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "pfSense ID of the certificate. This is the certificate's position in the configuration and may change when other certificates are removed; the provider locates the certificate by `refid` and records the new ID.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"refid": schema.StringAttribute{
+			MarkdownDescription: "Reference ID of the certificate, used by OpenVPN, IPsec and the webGUI to refer to it",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"method": schema.StringAttribute{
+			MarkdownDescription: "How the certificate is created: `existing` imports the PEM `certificate` and its private key, `internal` generates a key and a certificate signed by the CA `ca_refid` on the device, `csr` generates a key and a signing request on the device, to be signed elsewhere and given back in `certificate`. Changing it replaces the certificate.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(certificateMethodExisting, certificateMethodInternal, certificateMethodCSR),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Descriptive name of the certificate",
+			Required:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Certificate type, `server` or `user`",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("server"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("server", "user"),
+			},
+		},
+		"certificate": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded certificate. Required when `method` is `existing`, generated on the device when `method` is `internal`; when `method` is `csr`, set it to the signed certificate once the request has been signed.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"csr": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded signing request, when `method` is `csr`",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ca_refid": schema.StringAttribute{
+			MarkdownDescription: "Reference ID of the CA that signs the certificate. Required when `method` is `internal`; for other methods it is the issuer found on the device. Changing it replaces the certificate.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"dns_names": schema.ListAttribute{
+			MarkdownDescription: "DNS subject alternative names of a generated certificate or request",
+			ElementType:         types.StringType,
+			Optional:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"ip_addresses": schema.ListAttribute{
+			MarkdownDescription: "IP address subject alternative names of a generated certificate or request",
+			ElementType:         types.StringType,
+			Optional:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+	}
+	for name, attribute := range writeOnlyAttributes("private_key", "PEM encoded private key of an `existing` certificate") {
+		attributes[name] = attribute
+	}
+	for name, attribute := range certificateRequestAttributes(certificateLifetime) {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Certificate, imported from PEM, generated and signed by a CA on the device, or requested with a signing request. Can be imported by pfSense ID or by refid; import as `method = \"existing\"` (or `csr` for a pending request), since the parameters a certificate was generated with cannot be read back.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *CertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CertificateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Method.IsUnknown() {
+		return
+	}
+
+	validateCertificateMethod(&resp.Diagnostics, data.Method, &data.CertificateRequestModel, data.Certificate, data.PrivateKeyWO)
+
+	switch data.Method.ValueString() {
+	case certificateMethodInternal:
+		if data.CARefID.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("ca_refid"), "Missing Attribute", "`ca_refid` is required when `method` is `internal`.")
+		}
+	case certificateMethodExisting:
+		if data.DNSNames != nil || data.IPAddresses != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("method"), "Invalid Attribute Combination",
+				"Subject alternative names are only used when the key is generated on the device; they cannot be set when `method` is `existing`.")
+		}
+		fallthrough
+	default:
+		if !data.CARefID.IsNull() && !data.CARefID.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(path.Root("ca_refid"), "Invalid Attribute Combination",
+				"`ca_refid` can only be set when `method` is `internal`; the issuer of other certificates is found from the certificate.")
+		}
+	}
+}
+
+// ModifyPlan leaves the request and the issuer unknown when the certificate
+// changes: setting the signed certificate of a request may clear the request
+// on the device, and the issuer is found from the new certificate.
+func (r *CertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the certificate is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state CertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || data.Certificate.Equal(state.Certificate) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("csr"), types.StringUnknown())...)
+	if data.Method.ValueString() != certificateMethodInternal {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ca_refid"), types.StringUnknown())...)
+	}
+}
+
+func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate := data.toDomain()
+	var err error
+	switch data.Method.ValueString() {
+	case certificateMethodInternal:
+		certificate, err = r.client.GenerateCertificate(certificate, data.requestToDomain())
+	case certificateMethodCSR:
+		certificate, err = r.client.CreateCertificateSigningRequest(certificate, data.requestToDomain())
+	default:
+		certificate.PrivateKey = writeOnlyValue(ctx, req.Config, "private_key", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		certificate, err = r.client.CreateCertificate(certificate)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create certificate, got error: %s", err))
+		return
+	}
+	signed := data.Certificate
+	data.fromDomain(certificate)
+
+	tflog.Trace(ctx, "created a certificate", map[string]interface{}{"id": certificate.ID, "refid": certificate.RefID})
+
+	// Save data into Terraform state before completing a signing request so a failure does not orphan it
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Method.ValueString() != certificateMethodCSR || signed.IsNull() || signed.IsUnknown() {
+		return
+	}
+	data.Certificate = signed
+	certificate = data.toDomain()
+	certificate, err = r.client.UpdateCertificate(certificate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set signed certificate, got error: %s", err))
+		return
+	}
+	data.fromDomain(certificate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, drift, err := r.client.ResolveCertificate(int(data.ID.ValueInt64()), data.RefID.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read certificate, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(certificate)
+
+	// An imported certificate can only be described by its certificate, or by its request while it is pending
+	if data.Method.IsNull() {
+		data.Method = types.StringValue(certificateMethodExisting)
+		if certificate.Certificate == "" && certificate.CSR != "" {
+			data.Method = types.StringValue(certificateMethodCSR)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CertificateResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "certificate", func() (*pfsense_rest_v2.PFSenseCertificate, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveCertificate(int(state.ID.ValueInt64()), state.RefID.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	// The key is only sent when its version changed, since it cannot be compared with the device
+	certificate := data.toDomain()
+	if writeOnlyVersionChanged(data.PrivateKeyWOVersion, state.PrivateKeyWOVersion) {
+		certificate.PrivateKey = writeOnlyValue(ctx, req.Config, "private_key", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	certificate, err := r.client.UpdateCertificate(certificate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update certificate, got error: %s", err))
+		return
+	}
+	data.fromDomain(certificate)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "certificate", func() (*pfsense_rest_v2.PFSenseCertificate, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveCertificate(int(data.ID.ValueInt64()), data.RefID.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteCertificate(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete certificate, got error: %s", err))
+	}
+}
+
+func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Reference IDs are hexadecimal and may happen to be all digits, so they
+	// can always be given as refid:<refid>; any other value that is not a
+	// number is taken as a refid too.
+	key, value := importKey(req.ID, "refid")
+	id, err := strconv.Atoi(value)
+	if key != "" || err != nil {
+		certificates, err := r.client.GetCertificates()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read certificates, got error: %s", err))
+			return
+		}
+		certificate, err := findUnique(certificates, func(certificate *pfsense_rest_v2.PFSenseCertificate) bool {
+			return certificate.RefID == value
+		}, fmt.Sprintf("refid %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Certificate", err.Error())
+			return
+		}
+		id = certificate.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
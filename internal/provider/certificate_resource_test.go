@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var certificateRequestPEM = regexp.MustCompile(`^-----BEGIN CERTIFICATE REQUEST-----`)
+
+func TestAccCertificateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCertificateResourceConfig("tf-acc-test"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_certificate_authority.test",
+						tfjsonpath.New("refid"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_certificate.test",
+						tfjsonpath.New("type"),
+						knownvalue.StringExact("server"),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_certificate.request",
+						tfjsonpath.New("csr"),
+						knownvalue.StringRegexp(certificateRequestPEM),
+					),
+				},
+			},
+			// ImportState testing; the parameters the certificate was generated with cannot be imported
+			{
+				ResourceName:            "pfsense-v2_certificate.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"method", "common_name", "organization", "lifetime_days", "dns_names"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCertificateResourceConfig("tf-acc-test-updated"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_certificate.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("tf-acc-test-updated"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCertificateResourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_certificate_authority" "test" {
+  method      = "internal"
+  description = "tf-acc-test"
+  common_name = "tf-acc-test CA"
+}
+
+resource "pfsense-v2_certificate" "test" {
+  method        = "internal"
+  description   = %[1]q
+  ca_refid      = pfsense-v2_certificate_authority.test.refid
+  common_name   = "tf-acc-test.example.com"
+  organization  = "Terraform"
+  lifetime_days = 30
+  dns_names     = ["tf-acc-test.example.com"]
+}
+
+resource "pfsense-v2_certificate" "request" {
+  method      = "csr"
+  description = "tf-acc-test request"
+  common_name = "tf-acc-test-request.example.com"
+}
+`, description)
+}
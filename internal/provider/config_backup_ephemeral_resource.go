@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ConfigBackupEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ConfigBackupEphemeralResource{}
+
+func NewConfigBackupEphemeralResource() ephemeral.EphemeralResource {
+	return &ConfigBackupEphemeralResource{}
+}
+
+// ConfigBackupEphemeralResource defines the ephemeral resource implementation.
+type ConfigBackupEphemeralResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// ConfigBackupEphemeralResourceModel describes the ephemeral resource data model.
+type ConfigBackupEphemeralResourceModel struct {
+	Area    types.String `tfsdk:"area"`
+	Content types.String `tfsdk:"content"`
+}
+
+func (r *ConfigBackupEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_backup"
+}
+
+func (r *ConfigBackupEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The device's current config.xml, as a backup downloaded from Diagnostics > Backup & Restore would contain it. " +
+			"It includes password hashes, private keys and API keys, so it is only available as an ephemeral resource and is never stored in Terraform state or plan. " +
+			"Pass it to write-only arguments, e.g. of a secret store. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"area": schema.StringAttribute{
+				MarkdownDescription: "Only return this section of the configuration, e.g. `aliases`, `filter` or `nat`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9_]+$`), "must be the name of a config.xml section"),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The configuration XML",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *ConfigBackupEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConfigBackupEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ConfigBackupEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := r.client.GetConfig(data.Area.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration, got error: %s", err))
+		return
+	}
+	data.Content = types.StringValue(content)
+
+	tflog.Trace(ctx, "read configuration backup", map[string]interface{}{"area": data.Area.ValueString(), "size": len(content)})
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccConfigBackupEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Ephemeral resources are only available in 1.10 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			// One area of the configuration, echoed into state for the check
+			{
+				Config: `
+ephemeral "pfsense-v2_config_backup" "aliases" {
+  area = "aliases"
+}
+
+provider "echo" {
+  data = ephemeral.pfsense-v2_config_backup.aliases
+}
+
+resource "echo" "test" {}
+
+data "pfsense-v2_config_history" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("content"),
+						knownvalue.StringRegexp(regexp.MustCompile(`<aliases>`)),
+					),
+					statecheck.ExpectKnownValue(
+						"data.pfsense-v2_config_history.test",
+						tfjsonpath.New("revisions"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConfigHistoryDataSource{}
+
+func NewConfigHistoryDataSource() datasource.DataSource {
+	return &ConfigHistoryDataSource{}
+}
+
+// ConfigHistoryDataSource defines the data source implementation.
+type ConfigHistoryDataSource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// ConfigHistoryDataSourceModel describes the data source data model.
+type ConfigHistoryDataSourceModel struct {
+	Revisions []ConfigRevisionModel `tfsdk:"revisions"`
+}
+
+// ConfigRevisionModel describes one entry of revisions.
+type ConfigRevisionModel struct {
+	Time        types.Int64  `tfsdk:"time"`
+	Description types.String `tfsdk:"description"`
+	Version     types.String `tfsdk:"version"`
+	Size        types.Int64  `tfsdk:"size"`
+}
+
+func (d *ConfigHistoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_history"
+}
+
+func (d *ConfigHistoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Configuration revisions kept by the device. pfSense backs up the previous configuration whenever it writes a new one, " +
+			"so each revision is the configuration as it was until the change following it. The snapshots taken before each run are described as " +
+			"`Snapshot before Terraform changes`.",
+
+		Attributes: map[string]schema.Attribute{
+			"revisions": schema.ListNestedAttribute{
+				MarkdownDescription: "Revisions, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"time": schema.Int64Attribute{
+							MarkdownDescription: "When the revision was written, in seconds since the Unix epoch. Pass it to `pfsense-v2_config_restore` to restore the revision.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the change that wrote the revision",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Configuration format version",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size of the revision in bytes",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConfigHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConfigHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConfigHistoryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	revisions, err := d.client.GetConfigRevisions()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration history, got error: %s", err))
+		return
+	}
+	slices.SortStableFunc(revisions, func(a, b *pfsense_rest_v2.PFSenseConfigRevision) int {
+		return b.Time - a.Time
+	})
+
+	data.Revisions = []ConfigRevisionModel{}
+	for _, r := range revisions {
+		data.Revisions = append(data.Revisions, ConfigRevisionModel{
+			Time:        types.Int64Value(int64(r.Time)),
+			Description: types.StringValue(r.Description),
+			Version:     types.StringValue(r.Version),
+			Size:        types.Int64Value(int64(r.Size)),
+		})
+	}
+
+	tflog.Trace(ctx, "read configuration history", map[string]interface{}{"count": len(data.Revisions)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConfigRestoreResource{}
+
+func NewConfigRestoreResource() resource.Resource {
+	return &ConfigRestoreResource{}
+}
+
+// ConfigRestoreResource defines the resource implementation.
+type ConfigRestoreResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// ConfigRestoreResourceModel describes the resource data model.
+type ConfigRestoreResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	RevisionTime types.Int64  `tfsdk:"revision_time"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+}
+
+func (r *ConfigRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_restore"
+}
+
+func (r *ConfigRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Restores a configuration revision and reloads the device with it. The restore happens when the resource is created, " +
+			"or replaced because `revision_time` or `triggers` changed; destroying the resource does not change the device. " +
+			"Resources managing the restored objects show the difference on their next plan.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The restored revision time",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revision_time": schema.Int64Attribute{
+				MarkdownDescription: "Time of the revision to restore, as listed by the `pfsense-v2_config_history` data source",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that restore the revision again when they change",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConfigRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConfigRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConfigRestoreResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the revision first; a missing backup file would only show as a failed command
+	time := int(data.RevisionTime.ValueInt64())
+	revisions, err := r.client.GetConfigRevisions()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration history, got error: %s", err))
+		return
+	}
+	if !slices.ContainsFunc(revisions, func(revision *pfsense_rest_v2.PFSenseConfigRevision) bool { return revision.Time == time }) {
+		resp.Diagnostics.AddAttributeError(path.Root("revision_time"), "Revision Not Found",
+			fmt.Sprintf("The configuration history has no revision written at %d.", time))
+		return
+	}
+
+	if err := r.client.RestoreConfigRevision(time); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore configuration, got error: %s", err))
+		return
+	}
+	data.ID = types.StringValue(strconv.Itoa(time))
+
+	tflog.Trace(ctx, "restored a configuration revision", map[string]interface{}{"time": time})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the state as it is: the restore is a one-off action, not an
+// object on the device.
+func (r *ConfigRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update is never called with a change, since every attribute replaces the
+// resource.
+func (r *ConfigRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ConfigRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
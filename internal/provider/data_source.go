@@ -203,10 +203,12 @@ func (d *PFSenseDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	baseConfig, err := d.client.GetBaseConfig()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read base config, got error: %s", err))
+		return
 	}
 	firewallRulesResponse, err := d.client.GetFirewallRules()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rules, got error: %s", err))
+		return
 	}
 	var firewallRules PFSenseFirewallRules
 	for _, r := range firewallRulesResponse {
@@ -221,11 +223,11 @@ func (d *PFSenseDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			AddressFamily:   types.StringValue(r.AddressFamily),
 			Log:             types.BoolValue(r.Log),
 			Description:     types.StringValue(r.Description),
-			Protocol:        types.StringValue(r.Protocol),
+			Protocol:        types.StringPointerValue(r.Protocol),
 			Source:          types.StringValue(r.Source),
-			SourcePort:      types.StringValue(r.SourcePort),
+			SourcePort:      types.StringPointerValue(r.SourcePort),
 			Destination:     types.StringValue(r.Destination),
-			DestinationPort: types.StringValue(r.DestinationPort),
+			DestinationPort: types.StringPointerValue(r.DestinationPort),
 		})
 	}
 
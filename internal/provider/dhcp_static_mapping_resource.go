@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DHCPStaticMappingResource{}
+var _ resource.ResourceWithImportState = &DHCPStaticMappingResource{}
+
+func NewDHCPStaticMappingResource() resource.Resource {
+	return &DHCPStaticMappingResource{}
+}
+
+// DHCPStaticMappingResource defines the resource implementation.
+type DHCPStaticMappingResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// DHCPStaticMappingResourceModel describes the resource data model.
+type DHCPStaticMappingResourceModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Interface           types.String `tfsdk:"interface"`
+	MACAddress          types.String `tfsdk:"mac_address"`
+	IPAddress           types.String `tfsdk:"ip_address"`
+	ClientID            types.String `tfsdk:"client_id"`
+	Hostname            types.String `tfsdk:"hostname"`
+	Domain              types.String `tfsdk:"domain"`
+	Description         types.String `tfsdk:"description"`
+	ARPTableStaticEntry types.Bool   `tfsdk:"arp_table_static_entry"`
+}
+
+func (m *DHCPStaticMappingResourceModel) toDomain() *pfsense_rest_v2.PFSenseDHCPStaticMapping {
+	return &pfsense_rest_v2.PFSenseDHCPStaticMapping{
+		ID:                  int(m.ID.ValueInt64()),
+		Interface:           m.Interface.ValueString(),
+		MACAddress:          m.MACAddress.ValueString(),
+		IPAddress:           m.IPAddress.ValueStringPointer(),
+		ClientID:            m.ClientID.ValueStringPointer(),
+		Hostname:            m.Hostname.ValueStringPointer(),
+		Domain:              m.Domain.ValueStringPointer(),
+		Description:         m.Description.ValueString(),
+		ARPTableStaticEntry: m.ARPTableStaticEntry.ValueBool(),
+	}
+}
+
+func (m *DHCPStaticMappingResourceModel) fromDomain(mapping *pfsense_rest_v2.PFSenseDHCPStaticMapping) {
+	m.ID = types.Int64Value(int64(mapping.ID))
+	m.Interface = types.StringValue(mapping.Interface)
+	m.MACAddress = types.StringValue(mapping.MACAddress)
+	m.IPAddress = types.StringPointerValue(mapping.IPAddress)
+	m.ClientID = types.StringPointerValue(mapping.ClientID)
+	m.Hostname = types.StringPointerValue(mapping.Hostname)
+	m.Domain = types.StringPointerValue(mapping.Domain)
+	m.Description = types.StringValue(mapping.Description)
+	m.ARPTableStaticEntry = types.BoolValue(mapping.ARPTableStaticEntry)
+}
+
+func (r *DHCPStaticMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dhcp_server_static_mapping"
+}
+
+func (r *DHCPStaticMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DHCP server static mapping. Can be imported by `<interface>/<id>` or by `<interface>/<mac_address>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the mapping. This is the mapping's position in the interface's DHCP configuration and may change when other mappings are removed; the provider locates the mapping by `mac_address` and records the new ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface whose DHCP server owns the mapping",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mac_address": schema.StringAttribute{
+				MarkdownDescription: "MAC address of the client",
+				Required:            true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IPv4 address to assign to the client",
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "DHCP client identifier",
+				Optional:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname to assign to the client",
+				Optional:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain to assign to the client",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Mapping description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"arp_table_static_entry": schema.BoolAttribute{
+				MarkdownDescription: "Create a static ARP table entry for this mapping",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *DHCPStaticMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DHCPStaticMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DHCPStaticMappingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapping, err := r.client.CreateDHCPStaticMapping(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DHCP static mapping, got error: %s", err))
+		return
+	}
+	data.fromDomain(mapping)
+
+	tflog.Trace(ctx, "created a DHCP static mapping", map[string]interface{}{"interface": mapping.Interface, "id": mapping.ID})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the mapping
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyDHCPServer(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DHCP server changes, got error: %s", err))
+	}
+}
+
+func (r *DHCPStaticMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DHCPStaticMappingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapping, drift, err := r.client.ResolveDHCPStaticMapping(data.Interface.ValueString(), int(data.ID.ValueInt64()), data.MACAddress.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DHCP static mapping, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(mapping)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DHCPStaticMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DHCPStaticMappingResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "DHCP static mapping", func() (*pfsense_rest_v2.PFSenseDHCPStaticMapping, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveDHCPStaticMapping(state.Interface.ValueString(), int(state.ID.ValueInt64()), state.MACAddress.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	mapping, err := r.client.UpdateDHCPStaticMapping(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DHCP static mapping, got error: %s", err))
+		return
+	}
+	data.fromDomain(mapping)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyDHCPServer(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DHCP server changes, got error: %s", err))
+	}
+}
+
+func (r *DHCPStaticMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DHCPStaticMappingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "DHCP static mapping", func() (*pfsense_rest_v2.PFSenseDHCPStaticMapping, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveDHCPStaticMapping(data.Interface.ValueString(), int(data.ID.ValueInt64()), data.MACAddress.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteDHCPStaticMapping(current.Interface, current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DHCP static mapping, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyDHCPServer(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DHCP server changes, got error: %s", err))
+	}
+}
+
+func (r *DHCPStaticMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	iface, value, found := strings.Cut(req.ID, "/")
+	if !found || iface == "" || value == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected `<interface>/<id>` or `<interface>/<mac_address>`, got: %q", req.ID),
+		)
+		return
+	}
+
+	// MAC addresses always contain separators, so a bare number is a pfSense ID.
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		mappings, err := r.client.GetDHCPStaticMappings()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DHCP static mappings, got error: %s", err))
+			return
+		}
+		mapping, err := findUnique(mappings, func(mapping *pfsense_rest_v2.PFSenseDHCPStaticMapping) bool {
+			return mapping.Interface == iface && strings.EqualFold(mapping.MACAddress, value)
+		}, fmt.Sprintf("interface %q and MAC address %q", iface, value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import DHCP Static Mapping", err.Error())
+			return
+		}
+		id = mapping.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), iface)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSResolverAccessListResource{}
+var _ resource.ResourceWithImportState = &DNSResolverAccessListResource{}
+
+func NewDNSResolverAccessListResource() resource.Resource {
+	return &DNSResolverAccessListResource{}
+}
+
+// DNSResolverAccessListResource defines the resource implementation.
+type DNSResolverAccessListResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// DNSResolverAccessListResourceModel describes the resource data model.
+type DNSResolverAccessListResourceModel struct {
+	ID          types.Int64                         `tfsdk:"id"`
+	Name        types.String                        `tfsdk:"name"`
+	Action      types.String                        `tfsdk:"action"`
+	Description types.String                        `tfsdk:"description"`
+	Networks    []DNSResolverAccessListNetworkModel `tfsdk:"networks"`
+}
+
+// DNSResolverAccessListNetworkModel describes one entry of networks.
+type DNSResolverAccessListNetworkModel struct {
+	Network     types.String `tfsdk:"network"`
+	Mask        types.Int64  `tfsdk:"mask"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m *DNSResolverAccessListResourceModel) toDomain() *pfsense_rest_v2.PFSenseDNSResolverAccessList {
+	list := &pfsense_rest_v2.PFSenseDNSResolverAccessList{
+		ID:          int(m.ID.ValueInt64()),
+		Name:        m.Name.ValueString(),
+		Action:      m.Action.ValueString(),
+		Description: m.Description.ValueString(),
+		Networks:    []pfsense_rest_v2.PFSenseDNSResolverAccessListNetwork{},
+	}
+	for _, n := range m.Networks {
+		list.Networks = append(list.Networks, pfsense_rest_v2.PFSenseDNSResolverAccessListNetwork{
+			Network:     n.Network.ValueString(),
+			Mask:        int(n.Mask.ValueInt64()),
+			Description: n.Description.ValueString(),
+		})
+	}
+	return list
+}
+
+func (m *DNSResolverAccessListResourceModel) fromDomain(list *pfsense_rest_v2.PFSenseDNSResolverAccessList) {
+	m.ID = types.Int64Value(int64(list.ID))
+	m.Name = types.StringValue(list.Name)
+	m.Action = types.StringValue(list.Action)
+	m.Description = types.StringValue(list.Description)
+	m.Networks = []DNSResolverAccessListNetworkModel{}
+	for _, n := range list.Networks {
+		m.Networks = append(m.Networks, DNSResolverAccessListNetworkModel{
+			Network:     types.StringValue(n.Network),
+			Mask:        types.Int64Value(int64(n.Mask)),
+			Description: types.StringValue(n.Description),
+		})
+	}
+}
+
+func (r *DNSResolverAccessListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_resolver_access_list"
+}
+
+func (r *DNSResolverAccessListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNS resolver (Unbound) access list, controlling which client networks may query the resolver. Can be imported by pfSense ID or by list name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the access list. This is the list's position in the configuration and may change when other lists are removed; the provider locates the list by `name` and records the new ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Access list name",
+				Required:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "What to do with queries from `networks`. Supported values: allow, deny, refuse, allow snoop, deny nonlocal, refuse nonlocal.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("allow", "deny", "refuse", "allow snoop", "deny nonlocal", "refuse nonlocal"),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Access list description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"networks": schema.ListNestedAttribute{
+				MarkdownDescription: "Client networks the action applies to",
+				Required:            true,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network": schema.StringAttribute{
+							MarkdownDescription: "IPv4 or IPv6 network address",
+							Required:            true,
+						},
+						"mask": schema.Int64Attribute{
+							MarkdownDescription: "Prefix length of `network`",
+							Required:            true,
+							Validators:          []validator.Int64{int64validator.Between(0, 128)},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Network description",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DNSResolverAccessListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverAccessListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSResolverAccessListResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := r.client.CreateDNSResolverAccessList(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS resolver access list, got error: %s", err))
+		return
+	}
+	data.fromDomain(list)
+
+	tflog.Trace(ctx, "created a DNS resolver access list", map[string]interface{}{"id": list.ID, "name": list.Name})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the access list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverAccessListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSResolverAccessListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, drift, err := r.client.ResolveDNSResolverAccessList(int(data.ID.ValueInt64()), data.Name.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS resolver access list, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(list)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverAccessListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DNSResolverAccessListResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "DNS resolver access list", func() (*pfsense_rest_v2.PFSenseDNSResolverAccessList, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveDNSResolverAccessList(int(state.ID.ValueInt64()), state.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	list, err := r.client.UpdateDNSResolverAccessList(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS resolver access list, got error: %s", err))
+		return
+	}
+	data.fromDomain(list)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverAccessListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSResolverAccessListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "DNS resolver access list", func() (*pfsense_rest_v2.PFSenseDNSResolverAccessList, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveDNSResolverAccessList(int(data.ID.ValueInt64()), data.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteDNSResolverAccessList(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS resolver access list, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverAccessListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Access list names cannot be purely numeric, so anything that is not a number is a name.
+	_, value := importKey(req.ID, "name")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		lists, err := r.client.GetDNSResolverAccessLists()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS resolver access lists, got error: %s", err))
+			return
+		}
+		list, err := findUnique(lists, func(list *pfsense_rest_v2.PFSenseDNSResolverAccessList) bool {
+			return list.Name == value
+		}, fmt.Sprintf("name %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import DNS Resolver Access List", err.Error())
+			return
+		}
+		id = list.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDNSResolverAccessListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDNSResolverAccessListResourceConfig("allow"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_dns_resolver_access_list.test",
+						tfjsonpath.New("networks").AtSliceIndex(0).AtMapKey("mask"),
+						knownvalue.Int64Exact(24),
+					),
+				},
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "pfsense-v2_dns_resolver_access_list.test",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_test",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDNSResolverAccessListResourceConfig("refuse"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_dns_resolver_access_list.test",
+						tfjsonpath.New("action"),
+						knownvalue.StringExact("refuse"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDNSResolverAccessListResourceConfig(action string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_dns_resolver_access_list" "test" {
+  name     = "tf_acc_test"
+  action   = %[1]q
+  networks = [{ network = "10.99.0.0", mask = 24 }]
+}
+`, action)
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSResolverDomainOverrideResource{}
+var _ resource.ResourceWithImportState = &DNSResolverDomainOverrideResource{}
+var _ resource.ResourceWithValidateConfig = &DNSResolverDomainOverrideResource{}
+
+func NewDNSResolverDomainOverrideResource() resource.Resource {
+	return &DNSResolverDomainOverrideResource{}
+}
+
+// DNSResolverDomainOverrideResource defines the resource implementation.
+type DNSResolverDomainOverrideResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// DNSResolverDomainOverrideResourceModel describes the resource data model.
+type DNSResolverDomainOverrideResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Domain             types.String `tfsdk:"domain"`
+	Server             types.String `tfsdk:"server"`
+	Description        types.String `tfsdk:"description"`
+	ForwardTLSUpstream types.Bool   `tfsdk:"forward_tls_upstream"`
+	TLSHostname        types.String `tfsdk:"tls_hostname"`
+}
+
+func (m *DNSResolverDomainOverrideResourceModel) toDomain() *pfsense_rest_v2.PFSenseDNSResolverDomainOverride {
+	return &pfsense_rest_v2.PFSenseDNSResolverDomainOverride{
+		ID:                 int(m.ID.ValueInt64()),
+		Domain:             m.Domain.ValueString(),
+		Server:             m.Server.ValueString(),
+		Description:        m.Description.ValueString(),
+		ForwardTLSUpstream: m.ForwardTLSUpstream.ValueBool(),
+		TLSHostname:        m.TLSHostname.ValueString(),
+	}
+}
+
+func (m *DNSResolverDomainOverrideResourceModel) fromDomain(override *pfsense_rest_v2.PFSenseDNSResolverDomainOverride) {
+	m.ID = types.Int64Value(int64(override.ID))
+	m.Domain = types.StringValue(override.Domain)
+	m.Server = types.StringValue(override.Server)
+	m.Description = types.StringValue(override.Description)
+	m.ForwardTLSUpstream = types.BoolValue(override.ForwardTLSUpstream)
+	m.TLSHostname = types.StringValue(override.TLSHostname)
+}
+
+func (r *DNSResolverDomainOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_resolver_domain_override"
+}
+
+func (r *DNSResolverDomainOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNS resolver (Unbound) domain override. The resolver forwards queries for the domain and its subdomains to `server`. Can be imported by pfSense ID or by domain.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the override. This is the override's position in the configuration and may change when other overrides are removed; the provider locates the override by `domain` and `server` and records the new ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain whose queries are forwarded",
+				Required:            true,
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "Address of the authoritative server, optionally followed by `@<port>`. Forwarding one domain to several servers takes one override per server.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Override description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"forward_tls_upstream": schema.BoolAttribute{
+				MarkdownDescription: "Whether to forward queries to `server` over DNS over TLS",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tls_hostname": schema.StringAttribute{
+				MarkdownDescription: "Name the server's TLS certificate is verified against when `forward_tls_upstream` is set",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (r *DNSResolverDomainOverrideResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSResolverDomainOverrideResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TLSHostname.ValueString() != "" && !data.ForwardTLSUpstream.IsUnknown() && !data.ForwardTLSUpstream.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tls_hostname"),
+			"Invalid Attribute Combination",
+			"`tls_hostname` is only used when `forward_tls_upstream` is true.",
+		)
+	}
+}
+
+func (r *DNSResolverDomainOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverDomainOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSResolverDomainOverrideResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	override, err := r.client.CreateDNSResolverDomainOverride(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS resolver domain override, got error: %s", err))
+		return
+	}
+	data.fromDomain(override)
+
+	tflog.Trace(ctx, "created a DNS resolver domain override", map[string]interface{}{"id": override.ID, "domain": override.Domain})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the override
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverDomainOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSResolverDomainOverrideResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	override, drift, err := r.client.ResolveDNSResolverDomainOverride(int(data.ID.ValueInt64()), data.Domain.ValueString(), data.Server.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS resolver domain override, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(override)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverDomainOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DNSResolverDomainOverrideResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "DNS resolver domain override", func() (*pfsense_rest_v2.PFSenseDNSResolverDomainOverride, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveDNSResolverDomainOverride(int(state.ID.ValueInt64()), state.Domain.ValueString(), state.Server.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	override, err := r.client.UpdateDNSResolverDomainOverride(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS resolver domain override, got error: %s", err))
+		return
+	}
+	data.fromDomain(override)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverDomainOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSResolverDomainOverrideResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "DNS resolver domain override", func() (*pfsense_rest_v2.PFSenseDNSResolverDomainOverride, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveDNSResolverDomainOverride(int(data.ID.ValueInt64()), data.Domain.ValueString(), data.Server.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteDNSResolverDomainOverride(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS resolver domain override, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverDomainOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Domains always contain a letter, so anything that is not a number is a domain.
+	_, value := importKey(req.ID, "domain")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		overrides, err := r.client.GetDNSResolverDomainOverrides()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS resolver domain overrides, got error: %s", err))
+			return
+		}
+		override, err := findUnique(overrides, func(override *pfsense_rest_v2.PFSenseDNSResolverDomainOverride) bool {
+			return override.Domain == value
+		}, fmt.Sprintf("domain %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import DNS Resolver Domain Override", err.Error())
+			return
+		}
+		id = override.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSResolverHostOverrideResource{}
+var _ resource.ResourceWithImportState = &DNSResolverHostOverrideResource{}
+
+func NewDNSResolverHostOverrideResource() resource.Resource {
+	return &DNSResolverHostOverrideResource{}
+}
+
+// DNSResolverHostOverrideResource defines the resource implementation.
+type DNSResolverHostOverrideResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// DNSResolverHostOverrideResourceModel describes the resource data model.
+type DNSResolverHostOverrideResourceModel struct {
+	ID          types.Int64                         `tfsdk:"id"`
+	Host        types.String                        `tfsdk:"host"`
+	Domain      types.String                        `tfsdk:"domain"`
+	IP          []types.String                      `tfsdk:"ip"`
+	Description types.String                        `tfsdk:"description"`
+	Aliases     []DNSResolverHostOverrideAliasModel `tfsdk:"aliases"`
+}
+
+// DNSResolverHostOverrideAliasModel describes one entry of aliases.
+type DNSResolverHostOverrideAliasModel struct {
+	Host        types.String `tfsdk:"host"`
+	Domain      types.String `tfsdk:"domain"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m *DNSResolverHostOverrideResourceModel) toDomain() *pfsense_rest_v2.PFSenseDNSResolverHostOverride {
+	override := &pfsense_rest_v2.PFSenseDNSResolverHostOverride{
+		ID:          int(m.ID.ValueInt64()),
+		Host:        m.Host.ValueString(),
+		Domain:      m.Domain.ValueString(),
+		IPs:         stringsFromValues(m.IP),
+		Description: m.Description.ValueString(),
+		Aliases:     []pfsense_rest_v2.PFSenseDNSResolverHostOverrideAlias{},
+	}
+	for _, a := range m.Aliases {
+		override.Aliases = append(override.Aliases, pfsense_rest_v2.PFSenseDNSResolverHostOverrideAlias{
+			Host:        a.Host.ValueString(),
+			Domain:      a.Domain.ValueString(),
+			Description: a.Description.ValueString(),
+		})
+	}
+	return override
+}
+
+func (m *DNSResolverHostOverrideResourceModel) fromDomain(override *pfsense_rest_v2.PFSenseDNSResolverHostOverride) {
+	m.ID = types.Int64Value(int64(override.ID))
+	m.Host = types.StringValue(override.Host)
+	m.Domain = types.StringValue(override.Domain)
+	m.IP = stringValues(override.IPs)
+	m.Description = types.StringValue(override.Description)
+	m.Aliases = []DNSResolverHostOverrideAliasModel{}
+	for _, a := range override.Aliases {
+		m.Aliases = append(m.Aliases, DNSResolverHostOverrideAliasModel{
+			Host:        types.StringValue(a.Host),
+			Domain:      types.StringValue(a.Domain),
+			Description: types.StringValue(a.Description),
+		})
+	}
+}
+
+func (r *DNSResolverHostOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_resolver_host_override"
+}
+
+func (r *DNSResolverHostOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNS resolver (Unbound) host override. The resolver answers queries for the host, and for each of its aliases, with `ip` instead of forwarding them. Can be imported by pfSense ID or by fully qualified name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the override. This is the override's position in the configuration and may change when other overrides are removed; the provider locates the override by `host` and `domain` and records the new ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host name, without the domain. Leave empty to override `domain` itself.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain of the host",
+				Required:            true,
+			},
+			"ip": schema.ListAttribute{
+				MarkdownDescription: "IPv4 and IPv6 addresses returned for the host",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Override description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"aliases": schema.ListNestedAttribute{
+				MarkdownDescription: "Additional names resolving to the same addresses",
+				Optional:            true,
+				Computed:            true,
+				Default: listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{
+					"host":        types.StringType,
+					"domain":      types.StringType,
+					"description": types.StringType,
+				}}, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							MarkdownDescription: "Alias host name, without the domain",
+							Required:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Alias domain",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Alias description",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DNSResolverHostOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverHostOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSResolverHostOverrideResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	override, err := r.client.CreateDNSResolverHostOverride(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS resolver host override, got error: %s", err))
+		return
+	}
+	data.fromDomain(override)
+
+	tflog.Trace(ctx, "created a DNS resolver host override", map[string]interface{}{"id": override.ID, "fqdn": override.FQDN()})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the override
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverHostOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSResolverHostOverrideResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	override, drift, err := r.client.ResolveDNSResolverHostOverride(int(data.ID.ValueInt64()), data.Host.ValueString(), data.Domain.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS resolver host override, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(override)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverHostOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DNSResolverHostOverrideResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "DNS resolver host override", func() (*pfsense_rest_v2.PFSenseDNSResolverHostOverride, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveDNSResolverHostOverride(int(state.ID.ValueInt64()), state.Host.ValueString(), state.Domain.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	override, err := r.client.UpdateDNSResolverHostOverride(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS resolver host override, got error: %s", err))
+		return
+	}
+	data.fromDomain(override)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverHostOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSResolverHostOverrideResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "DNS resolver host override", func() (*pfsense_rest_v2.PFSenseDNSResolverHostOverride, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveDNSResolverHostOverride(int(data.ID.ValueInt64()), data.Host.ValueString(), data.Domain.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteDNSResolverHostOverride(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS resolver host override, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverHostOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Domains always contain a letter, so anything that is not a number is a fully qualified name.
+	_, value := importKey(req.ID, "fqdn")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		overrides, err := r.client.GetDNSResolverHostOverrides()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS resolver host overrides, got error: %s", err))
+			return
+		}
+		override, err := findUnique(overrides, func(override *pfsense_rest_v2.PFSenseDNSResolverHostOverride) bool {
+			return override.FQDN() == value
+		}, fmt.Sprintf("name %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import DNS Resolver Host Override", err.Error())
+			return
+		}
+		id = override.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
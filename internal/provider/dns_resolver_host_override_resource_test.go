@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDNSResolverHostOverrideResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, with the name pointing at a DHCP reservation
+			{
+				Config: testAccDNSResolverHostOverrideResourceConfig("192.168.1.250"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_dns_resolver_host_override.test",
+						tfjsonpath.New("ip"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("192.168.1.250")}),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_dns_resolver_host_override.test",
+						tfjsonpath.New("aliases").AtSliceIndex(0).AtMapKey("host"),
+						knownvalue.StringExact("tf-acc-test-alias"),
+					),
+				},
+			},
+			// ImportState testing by fully qualified name
+			{
+				ResourceName:      "pfsense-v2_dns_resolver_host_override.test",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-test.home.arpa",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDNSResolverHostOverrideResourceConfig("192.168.1.251"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_dns_resolver_host_override.test",
+						tfjsonpath.New("ip"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("192.168.1.251")}),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDNSResolverHostOverrideResourceConfig(ip string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_dhcp_server_static_mapping" "test" {
+  interface   = "lan"
+  mac_address = "02:00:00:00:00:fe"
+  ip_address  = %[1]q
+  hostname    = "tf-acc-test"
+}
+
+resource "pfsense-v2_dns_resolver_host_override" "test" {
+  host    = pfsense-v2_dhcp_server_static_mapping.test.hostname
+  domain  = "home.arpa"
+  ip      = [pfsense-v2_dhcp_server_static_mapping.test.ip_address]
+  aliases = [{ host = "tf-acc-test-alias", domain = "home.arpa" }]
+}
+`, ip)
+}
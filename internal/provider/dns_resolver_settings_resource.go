@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSResolverSettingsResource{}
+var _ resource.ResourceWithImportState = &DNSResolverSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &DNSResolverSettingsResource{}
+
+const dnsResolverSettingsID = "dns_resolver_settings"
+
+func NewDNSResolverSettingsResource() resource.Resource {
+	return &DNSResolverSettingsResource{}
+}
+
+// DNSResolverSettingsResource defines the resource implementation.
+type DNSResolverSettingsResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// DNSResolverSettingsResourceModel describes the resource data model.
+type DNSResolverSettingsResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Enabled            types.Bool     `tfsdk:"enabled"`
+	Port               types.String   `tfsdk:"port"`
+	ActiveInterfaces   []types.String `tfsdk:"active_interfaces"`
+	OutgoingInterfaces []types.String `tfsdk:"outgoing_interfaces"`
+	StrictOutgoing     types.Bool     `tfsdk:"strict_outgoing"`
+	DNSSEC             types.Bool     `tfsdk:"dnssec"`
+	Forwarding         types.Bool     `tfsdk:"forwarding"`
+	ForwardTLSUpstream types.Bool     `tfsdk:"forward_tls_upstream"`
+	RegisterDHCP       types.Bool     `tfsdk:"register_dhcp"`
+	RegisterDHCPStatic types.Bool     `tfsdk:"register_dhcp_static"`
+	RegisterOpenVPN    types.Bool     `tfsdk:"register_openvpn"`
+	CustomOptions      types.String   `tfsdk:"custom_options"`
+	Python             types.Bool     `tfsdk:"python"`
+	PythonOrder        types.String   `tfsdk:"python_order"`
+	PythonScript       types.String   `tfsdk:"python_script"`
+}
+
+func (m *DNSResolverSettingsResourceModel) toDomain() *pfsense_rest_v2.PFSenseDNSResolverSettings {
+	return &pfsense_rest_v2.PFSenseDNSResolverSettings{
+		Enabled:            m.Enabled.ValueBool(),
+		Port:               m.Port.ValueString(),
+		ActiveInterfaces:   stringsFromValues(m.ActiveInterfaces),
+		OutgoingInterfaces: stringsFromValues(m.OutgoingInterfaces),
+		StrictOutgoing:     m.StrictOutgoing.ValueBool(),
+		DNSSEC:             m.DNSSEC.ValueBool(),
+		Forwarding:         m.Forwarding.ValueBool(),
+		ForwardTLSUpstream: m.ForwardTLSUpstream.ValueBool(),
+		RegisterDHCP:       m.RegisterDHCP.ValueBool(),
+		RegisterDHCPStatic: m.RegisterDHCPStatic.ValueBool(),
+		RegisterOpenVPN:    m.RegisterOpenVPN.ValueBool(),
+		CustomOptions:      m.CustomOptions.ValueString(),
+		Python:             m.Python.ValueBool(),
+		PythonOrder:        m.PythonOrder.ValueString(),
+		PythonScript:       m.PythonScript.ValueString(),
+	}
+}
+
+func (m *DNSResolverSettingsResourceModel) fromDomain(settings *pfsense_rest_v2.PFSenseDNSResolverSettings) {
+	m.ID = types.StringValue(dnsResolverSettingsID)
+	m.Enabled = types.BoolValue(settings.Enabled)
+	m.Port = types.StringValue(settings.Port)
+	m.ActiveInterfaces = stringValues(settings.ActiveInterfaces)
+	m.OutgoingInterfaces = stringValues(settings.OutgoingInterfaces)
+	m.StrictOutgoing = types.BoolValue(settings.StrictOutgoing)
+	m.DNSSEC = types.BoolValue(settings.DNSSEC)
+	m.Forwarding = types.BoolValue(settings.Forwarding)
+	m.ForwardTLSUpstream = types.BoolValue(settings.ForwardTLSUpstream)
+	m.RegisterDHCP = types.BoolValue(settings.RegisterDHCP)
+	m.RegisterDHCPStatic = types.BoolValue(settings.RegisterDHCPStatic)
+	m.RegisterOpenVPN = types.BoolValue(settings.RegisterOpenVPN)
+	m.CustomOptions = types.StringValue(settings.CustomOptions)
+	m.Python = types.BoolValue(settings.Python)
+	m.PythonOrder = types.StringValue(settings.PythonOrder)
+	m.PythonScript = types.StringValue(settings.PythonScript)
+}
+
+func (r *DNSResolverSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_resolver_settings"
+}
+
+func (r *DNSResolverSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	allInterfaces := listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("all")}))
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Global DNS resolver (Unbound) settings. There is one instance per device: creating the resource overwrites the current settings, and destroying it leaves them in place. Unset attributes are reset to the pfSense defaults. Can be imported with any ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": singletonIDAttribute(dnsResolverSettingsID),
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the DNS resolver is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "Port the resolver listens on",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("53"),
+			},
+			"active_interfaces": schema.ListAttribute{
+				MarkdownDescription: "Interface IDs the resolver listens on, or `[\"all\"]`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             allInterfaces,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"outgoing_interfaces": schema.ListAttribute{
+				MarkdownDescription: "Interface IDs the resolver sends queries from, or `[\"all\"]`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             allInterfaces,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"strict_outgoing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to drop queries that cannot leave through `outgoing_interfaces`, instead of using the routing table",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Whether to validate answers with DNSSEC",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"forwarding": schema.BoolAttribute{
+				MarkdownDescription: "Whether to forward queries to the system DNS servers instead of resolving them from the root servers",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"forward_tls_upstream": schema.BoolAttribute{
+				MarkdownDescription: "Whether forwarded queries use DNS over TLS. Requires `forwarding`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"register_dhcp": schema.BoolAttribute{
+				MarkdownDescription: "Whether DHCP clients are registered in the resolver under the hostname they send",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"register_dhcp_static": schema.BoolAttribute{
+				MarkdownDescription: "Whether DHCP static mappings are registered in the resolver",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"register_openvpn": schema.BoolAttribute{
+				MarkdownDescription: "Whether OpenVPN clients are registered in the resolver under their common name",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"custom_options": schema.StringAttribute{
+				MarkdownDescription: "Additional `unbound.conf` configuration, placed in the `server:` section unless it starts another section",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"python": schema.BoolAttribute{
+				MarkdownDescription: "Whether to load the Unbound python module",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"python_order": schema.StringAttribute{
+				MarkdownDescription: "Whether the python module runs before (`pre_validator`) or after (`post_validator`) DNSSEC validation",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("pre_validator"),
+				Validators:          []validator.String{stringvalidator.OneOf("pre_validator", "post_validator")},
+			},
+			"python_script": schema.StringAttribute{
+				MarkdownDescription: "Name of the python module script to load. Required when `python` is set.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (r *DNSResolverSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSResolverSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ForwardTLSUpstream.ValueBool() && !data.Forwarding.IsUnknown() && !data.Forwarding.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("forward_tls_upstream"),
+			"Invalid Attribute Combination",
+			"`forward_tls_upstream` requires `forwarding`.",
+		)
+	}
+	if data.Python.ValueBool() && !data.PythonScript.IsUnknown() && data.PythonScript.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("python_script"),
+			"Missing Attribute",
+			"`python_script` is required when `python` is true.",
+		)
+	}
+}
+
+func (r *DNSResolverSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSResolverSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "took over the DNS resolver settings")
+}
+
+func (r *DNSResolverSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSResolverSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetDNSResolverSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS resolver settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSResolverSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+// update writes the planned settings and applies them. Create and Update are
+// the same operation for a settings resource.
+func (r *DNSResolverSettingsResource) update(ctx context.Context, data *DNSResolverSettingsResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings, err := r.client.UpdateDNSResolverSettings(data.toDomain())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update DNS resolver settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	// Save updated data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+
+	if err := r.client.ApplyDNSResolver(); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to apply DNS resolver changes, got error: %s", err))
+	}
+}
+
+func (r *DNSResolverSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	addSingletonDeleteWarning(&resp.Diagnostics, "DNS resolver settings")
+}
+
+func (r *DNSResolverSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSingleton(ctx, dnsResolverSettingsID, resp)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDNSResolverSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDNSResolverSettingsResourceConfig(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_dns_resolver_settings.test",
+						tfjsonpath.New("register_dhcp_static"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pfsense-v2_dns_resolver_settings.test",
+				ImportState:       true,
+				ImportStateId:     "dns_resolver_settings",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDNSResolverSettingsResourceConfig(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_dns_resolver_settings.test",
+						tfjsonpath.New("register_dhcp_static"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDNSResolverSettingsResourceConfig(registerStatic bool) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_dns_resolver_settings" "test" {
+  register_dhcp_static = %[1]t
+}
+`, registerStatic)
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallAliasResource{}
+var _ resource.ResourceWithImportState = &FirewallAliasResource{}
+var _ resource.ResourceWithModifyPlan = &FirewallAliasResource{}
+
+func NewFirewallAliasResource() resource.Resource {
+	return &FirewallAliasResource{}
+}
+
+// FirewallAliasResource defines the resource implementation.
+type FirewallAliasResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// FirewallAliasResourceModel describes the resource data model.
+type FirewallAliasResourceModel struct {
+	ID          types.Int64    `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Type        types.String   `tfsdk:"type"`
+	Description types.String   `tfsdk:"description"`
+	Addresses   []types.String `tfsdk:"addresses"`
+	Details     []types.String `tfsdk:"details"`
+}
+
+func (m *FirewallAliasResourceModel) toDomain() *pfsense_rest_v2.PFSenseFirewallAlias {
+	return &pfsense_rest_v2.PFSenseFirewallAlias{
+		ID:          int(m.ID.ValueInt64()),
+		Name:        m.Name.ValueString(),
+		Type:        m.Type.ValueString(),
+		Description: m.Description.ValueString(),
+		Addresses:   stringsFromValues(m.Addresses),
+		Details:     stringsFromValues(m.Details),
+	}
+}
+
+func (m *FirewallAliasResourceModel) fromDomain(alias *pfsense_rest_v2.PFSenseFirewallAlias) {
+	m.ID = types.Int64Value(int64(alias.ID))
+	m.Name = types.StringValue(alias.Name)
+	m.Type = types.StringValue(alias.Type)
+	m.Description = types.StringValue(alias.Description)
+	m.Addresses = stringValues(alias.Addresses)
+	m.Details = stringValues(alias.Details)
+}
+
+func (r *FirewallAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_alias"
+}
+
+func (r *FirewallAliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptyList := listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{}))
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Firewall alias. Can be imported by pfSense ID or by alias name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the alias. This is the alias's position in the configuration and may change when other aliases are removed; the provider locates the alias by `name` and records the new ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Alias name, as referenced by rules and other aliases",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Alias type",
+				Required:            true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(pfsense_rest_v2.FirewallAliasTypeHost),
+					string(pfsense_rest_v2.FirewallAliasTypeNetwork),
+					string(pfsense_rest_v2.FirewallAliasTypePort),
+				)},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Alias description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"addresses": schema.ListAttribute{
+				MarkdownDescription: "Hosts, networks or ports contained in the alias, depending on `type`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptyList,
+			},
+			"details": schema.ListAttribute{
+				MarkdownDescription: "Description of each entry in `addresses`, by position",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptyList,
+			},
+		},
+	}
+}
+
+func (r *FirewallAliasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || !r.client.ValidateReferences {
+		return
+	}
+
+	// Announce the alias so rules planned after it may refer to it before it exists
+	var name, aliasType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &aliasType)...)
+	if name.IsNull() || name.IsUnknown() || aliasType.IsUnknown() {
+		return
+	}
+	r.client.PlanFirewallAlias(name.ValueString(), aliasType.ValueString())
+}
+
+func (r *FirewallAliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallAliasResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.client.CreateFirewallAlias(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create firewall alias, got error: %s", err))
+		return
+	}
+	data.fromDomain(alias)
+
+	tflog.Trace(ctx, "created a firewall alias", map[string]interface{}{"id": alias.ID, "name": alias.Name})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the alias
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *FirewallAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallAliasResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, drift, err := r.client.ResolveFirewallAlias(int(data.ID.ValueInt64()), data.Name.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall alias, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(alias)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FirewallAliasResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "firewall alias", func() (*pfsense_rest_v2.PFSenseFirewallAlias, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveFirewallAlias(int(state.ID.ValueInt64()), state.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	alias, err := r.client.UpdateFirewallAlias(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall alias, got error: %s", err))
+		return
+	}
+	data.fromDomain(alias)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *FirewallAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallAliasResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "firewall alias", func() (*pfsense_rest_v2.PFSenseFirewallAlias, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveFirewallAlias(int(data.ID.ValueInt64()), data.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteFirewallAlias(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall alias, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *FirewallAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Alias names cannot be purely numeric, so anything that is not a number is a name.
+	_, value := importKey(req.ID, "name")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		aliases, err := r.client.GetFirewallAliases()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall aliases, got error: %s", err))
+			return
+		}
+		alias, err := findUnique(aliases, func(alias *pfsense_rest_v2.PFSenseFirewallAlias) bool {
+			return alias.Name == value
+		}, fmt.Sprintf("name %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Firewall Alias", err.Error())
+			return
+		}
+		id = alias.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFirewallAliasResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallAliasResourceConfig("10.0.0.1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_alias.test",
+						tfjsonpath.New("addresses"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("10.0.0.1")}),
+					),
+				},
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "pfsense-v2_firewall_alias.test",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_test",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallAliasResourceConfig("10.0.0.2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_alias.test",
+						tfjsonpath.New("addresses"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("10.0.0.2")}),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFirewallAliasResourceConfig(address string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_firewall_alias" "test" {
+  name      = "tf_acc_test"
+  type      = "host"
+  addresses = [%[1]q]
+  details   = ["acceptance test host"]
+}
+`, address)
+}
@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallFloatingRuleResource{}
+var _ resource.ResourceWithImportState = &FirewallFloatingRuleResource{}
+var _ resource.ResourceWithModifyPlan = &FirewallFloatingRuleResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallFloatingRuleResource{}
+
+// firewallRuleTypeMatch is the rule type that only applies queues, limiters
+// and gateways without passing or blocking. pfSense allows it on floating
+// rules only.
+const firewallRuleTypeMatch = "match"
+
+func NewFirewallFloatingRuleResource() resource.Resource {
+	return &FirewallFloatingRuleResource{}
+}
+
+// FirewallFloatingRuleResource defines the resource implementation.
+type FirewallFloatingRuleResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// FirewallFloatingRuleResourceModel describes the resource data model. It
+// extends the interface rule model with the floating-only options.
+type FirewallFloatingRuleResourceModel struct {
+	FirewallRuleResourceModel
+	Direction types.String `tfsdk:"direction"`
+	Quick     types.Bool   `tfsdk:"quick"`
+}
+
+func (m *FirewallFloatingRuleResourceModel) toDomain() *pfsense_rest_v2.PFSenseFirewallRule {
+	rule := m.FirewallRuleResourceModel.toDomain()
+	rule.Floating = true
+	rule.Direction = m.Direction.ValueString()
+	rule.Quick = m.Quick.ValueBool()
+	return rule
+}
+
+func (m *FirewallFloatingRuleResourceModel) fromDomain(rule *pfsense_rest_v2.PFSenseFirewallRule) {
+	m.FirewallRuleResourceModel.fromDomain(rule)
+	m.Direction = types.StringValue(rule.Direction)
+	m.Quick = types.BoolValue(rule.Quick)
+}
+
+func (r *FirewallFloatingRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_floating_rule"
+}
+
+func (r *FirewallFloatingRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := firewallRuleAttributes()
+	attributes["type"] = schema.StringAttribute{
+		MarkdownDescription: "Rule type. `match` rules neither pass nor block traffic; they only apply `sched`, limiters, queues and other options to the traffic they match.",
+		Required:            true,
+		Validators: []validator.String{stringvalidator.OneOf(
+			string(pfsense_rest_v2.FirewallRuleTypePass),
+			string(pfsense_rest_v2.FirewallRuleTypeBlock),
+			string(pfsense_rest_v2.FirewallRuleTypeReject),
+			firewallRuleTypeMatch,
+		)},
+	}
+	attributes["interfaces"] = schema.ListAttribute{
+		MarkdownDescription: "Interfaces this rule applies to",
+		ElementType:         types.StringType,
+		Required:            true,
+		Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+	}
+	attributes["direction"] = schema.StringAttribute{
+		MarkdownDescription: "Direction of the traffic this rule matches: `in`, `out` or `any`",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(string(pfsense_rest_v2.FirewallRuleDirectionAny)),
+		Validators: []validator.String{stringvalidator.OneOf(
+			string(pfsense_rest_v2.FirewallRuleDirectionIn),
+			string(pfsense_rest_v2.FirewallRuleDirectionOut),
+			string(pfsense_rest_v2.FirewallRuleDirectionAny),
+		)},
+	}
+	attributes["quick"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the rule applies as soon as it matches. Without `quick`, floating rules only apply when no later rule matches, including the interface rules.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Floating firewall rule, matching traffic on any number of interfaces in either direction. " +
+			"Floating rules are evaluated before interface rules. Can be imported by pfSense ID, " +
+			"by `tracker:<tracker>` or by `description:<description>`.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *FirewallFloatingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateFirewallRule(ctx, req.Config, &resp.Diagnostics)
+
+	config := configStrings(ctx, req.Config, &resp.Diagnostics, "type", "direction", "gateway")
+	var quick types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("quick"), &quick)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config["type"].ValueString() == firewallRuleTypeMatch && quick.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("quick"),
+			"Invalid Attribute Combination",
+			"`match` rules never stop rule evaluation, so `quick` cannot be set on them.",
+		)
+	}
+	// A null direction is the schema default of any.
+	direction := config["direction"]
+	if !config["gateway"].IsNull() && !direction.IsUnknown() && (direction.IsNull() || direction.ValueString() == string(pfsense_rest_v2.FirewallRuleDirectionAny)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("gateway"),
+			"Invalid Attribute Combination",
+			"`gateway` can only be set on floating rules with `direction` in or out.",
+		)
+	}
+}
+
+func (r *FirewallFloatingRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the rule is being destroyed
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	checkGateway(ctx, r.client, req.Plan, &resp.Diagnostics)
+
+	if !r.client.ValidateReferences {
+		return
+	}
+
+	checkReferences(ctx, r.client, req.Plan, referenceAttributes{
+		Addresses:      []string{"source", "destination"},
+		Ports:          []string{"source_port", "destination_port"},
+		Interfaces:     []string{"interfaces"},
+		InterfaceLists: true,
+	}, &resp.Diagnostics)
+}
+
+func (r *FirewallFloatingRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallFloatingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallFloatingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.CreateFirewallRule(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create floating rule, got error: %s", err))
+		return
+	}
+	data.fromDomain(rule)
+
+	tflog.Trace(ctx, "created a floating rule", map[string]interface{}{"id": rule.ID, "tracker": rule.Tracker})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the rule
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+		return
+	}
+
+	if data.KillStatesOnChange.ValueBool() {
+		killStatesAfterChange(ctx, r.client, rule, &resp.Diagnostics)
+	}
+}
+
+func (r *FirewallFloatingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallFloatingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, drift, err := r.client.ResolveFirewallRule(int(data.ID.ValueInt64()), int(data.Tracker.ValueInt64()))
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read floating rule, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(rule)
+	// The option is not stored on pfSense, so imported rules start without it.
+	if data.KillStatesOnChange.IsNull() {
+		data.KillStatesOnChange = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallFloatingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FirewallFloatingRuleResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "floating rule", func() (*pfsense_rest_v2.PFSenseFirewallRule, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveFirewallRule(int(state.ID.ValueInt64()), int(state.Tracker.ValueInt64()))
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	rule, err := r.client.UpdateFirewallRule(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update floating rule, got error: %s", err))
+		return
+	}
+	data.fromDomain(rule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+		return
+	}
+
+	if data.KillStatesOnChange.ValueBool() {
+		killStatesAfterChange(ctx, r.client, rule, &resp.Diagnostics)
+	}
+}
+
+func (r *FirewallFloatingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallFloatingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "floating rule", func() (*pfsense_rest_v2.PFSenseFirewallRule, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveFirewallRule(int(data.ID.ValueInt64()), int(data.Tracker.ValueInt64()))
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteFirewallRule(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete floating rule, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *FirewallFloatingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	key, value := importKey(req.ID, "tracker", "description")
+
+	var id int
+	switch key {
+	case "tracker", "description":
+		rules, err := r.client.GetFirewallRules()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rules, got error: %s", err))
+			return
+		}
+		rule, err := findUnique(rules, func(rule *pfsense_rest_v2.PFSenseFirewallRule) bool {
+			if !rule.Floating {
+				return false
+			}
+			if key == "tracker" {
+				return strconv.Itoa(rule.Tracker) == value
+			}
+			return rule.Description == value
+		}, fmt.Sprintf("%s %q", key, value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Floating Rule", err.Error())
+			return
+		}
+		id = rule.ID
+	default:
+		var err error
+		id, err = strconv.Atoi(value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected a numeric pfSense ID, `tracker:<tracker>` or `description:<description>`, got: %q", req.ID),
+			)
+			return
+		}
+
+		// Both resources share the rules endpoint, so make sure the rule is of the right kind
+		rule, err := r.client.GetFirewallRule(id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rule, got error: %s", err))
+			return
+		}
+		if !rule.Floating {
+			resp.Diagnostics.AddError("Unable to Import Floating Rule", fmt.Sprintf("pfSense ID %d is not a floating rule; import it as a pfsense-v2_firewall_rule.", id))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFirewallFloatingRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallFloatingRuleResourceConfig("in"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_floating_rule.test",
+						tfjsonpath.New("direction"),
+						knownvalue.StringExact("in"),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_floating_rule.test",
+						tfjsonpath.New("interfaces"),
+						knownvalue.ListSizeExact(2),
+					),
+				},
+			},
+			// ImportState testing by tracker
+			{
+				ResourceName:      "pfsense-v2_firewall_floating_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "tracker:" + s.RootModule().Resources["pfsense-v2_firewall_floating_rule.test"].Primary.Attributes["tracker"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallFloatingRuleResourceConfig("any"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_floating_rule.test",
+						tfjsonpath.New("direction"),
+						knownvalue.StringExact("any"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFirewallFloatingRuleResource_QuickMatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "pfsense-v2_firewall_floating_rule" "test" {
+  type       = "match"
+  interfaces = ["wan"]
+  quick      = true
+}
+`,
+				ExpectError: regexp.MustCompile("`quick` cannot be set"),
+			},
+		},
+	})
+}
+
+func testAccFirewallFloatingRuleResourceConfig(direction string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_firewall_floating_rule" "test" {
+  type        = "block"
+  interfaces  = ["wan", "lan"]
+  direction   = %[1]q
+  quick       = true
+  protocol    = "icmp"
+  icmptype    = ["echoreq"]
+  description = "terraform acceptance test floating rule"
+}
+`, direction)
+}
@@ -0,0 +1,549 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallRuleResource{}
+var _ resource.ResourceWithImportState = &FirewallRuleResource{}
+var _ resource.ResourceWithModifyPlan = &FirewallRuleResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallRuleResource{}
+
+func NewFirewallRuleResource() resource.Resource {
+	return &FirewallRuleResource{}
+}
+
+// FirewallRuleResource defines the resource implementation.
+type FirewallRuleResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// FirewallRuleResourceModel describes the resource data model.
+type FirewallRuleResourceModel struct {
+	ID                 types.Int64    `tfsdk:"id"`
+	Tracker            types.Int64    `tfsdk:"tracker"`
+	Type               types.String   `tfsdk:"type"`
+	Interfaces         []types.String `tfsdk:"interfaces"`
+	Disabled           types.Bool     `tfsdk:"disabled"`
+	AddressFamily      types.String   `tfsdk:"address_family"`
+	Log                types.Bool     `tfsdk:"log"`
+	Description        types.String   `tfsdk:"description"`
+	KillStatesOnChange types.Bool     `tfsdk:"kill_states_on_change"` // not sent to pfSense
+	Protocol           types.String   `tfsdk:"protocol"`
+	Source             types.String   `tfsdk:"source"`
+	SourcePort         types.String   `tfsdk:"source_port"`
+	Destination        types.String   `tfsdk:"destination"`
+	DestinationPort    types.String   `tfsdk:"destination_port"`
+	Schedule           types.String   `tfsdk:"sched"`
+	InPipe             types.String   `tfsdk:"dnpipe"`
+	OutPipe            types.String   `tfsdk:"pdnpipe"`
+	DefaultQueue       types.String   `tfsdk:"defaultqueue"`
+	AckQueue           types.String   `tfsdk:"ackqueue"`
+	Gateway            types.String   `tfsdk:"gateway"`
+	ICMPTypes          []types.String `tfsdk:"icmptype"`
+	StateType          types.String   `tfsdk:"statetype"`
+	TCPFlagsAny        types.Bool     `tfsdk:"tcp_flags_any"`
+	TCPFlagsOutOf      []types.String `tfsdk:"tcp_flags_out_of"`
+	TCPFlagsSet        []types.String `tfsdk:"tcp_flags_set"`
+}
+
+func (m *FirewallRuleResourceModel) toDomain() *pfsense_rest_v2.PFSenseFirewallRule {
+	return &pfsense_rest_v2.PFSenseFirewallRule{
+		ID:              int(m.ID.ValueInt64()),
+		Tracker:         int(m.Tracker.ValueInt64()),
+		Type:            m.Type.ValueString(),
+		Interfaces:      stringsFromValues(m.Interfaces),
+		Disabled:        m.Disabled.ValueBool(),
+		AddressFamily:   m.AddressFamily.ValueString(),
+		Log:             m.Log.ValueBool(),
+		Description:     m.Description.ValueString(),
+		Protocol:        m.Protocol.ValueStringPointer(),
+		Source:          m.Source.ValueString(),
+		SourcePort:      m.SourcePort.ValueStringPointer(),
+		Destination:     m.Destination.ValueString(),
+		DestinationPort: m.DestinationPort.ValueStringPointer(),
+		Schedule:        m.Schedule.ValueStringPointer(),
+		InPipe:          m.InPipe.ValueStringPointer(),
+		OutPipe:         m.OutPipe.ValueStringPointer(),
+		DefaultQueue:    m.DefaultQueue.ValueStringPointer(),
+		AckQueue:        m.AckQueue.ValueStringPointer(),
+		Gateway:         m.Gateway.ValueStringPointer(),
+		ICMPTypes:       stringsFromValues(m.ICMPTypes),
+		StateType:       m.StateType.ValueString(),
+		TCPFlagsAny:     m.TCPFlagsAny.ValueBool(),
+		TCPFlagsOutOf:   stringsFromValues(m.TCPFlagsOutOf),
+		TCPFlagsSet:     stringsFromValues(m.TCPFlagsSet),
+	}
+}
+
+func (m *FirewallRuleResourceModel) fromDomain(rule *pfsense_rest_v2.PFSenseFirewallRule) {
+	m.ID = types.Int64Value(int64(rule.ID))
+	m.Tracker = types.Int64Value(int64(rule.Tracker))
+	m.Type = types.StringValue(rule.Type)
+	m.Interfaces = stringValues(rule.Interfaces)
+	m.Disabled = types.BoolValue(rule.Disabled)
+	m.AddressFamily = types.StringValue(rule.AddressFamily)
+	m.Log = types.BoolValue(rule.Log)
+	m.Description = types.StringValue(rule.Description)
+	m.Protocol = types.StringPointerValue(rule.Protocol)
+	m.Source = types.StringValue(rule.Source)
+	m.SourcePort = types.StringPointerValue(rule.SourcePort)
+	m.Destination = types.StringValue(rule.Destination)
+	m.DestinationPort = types.StringPointerValue(rule.DestinationPort)
+	m.Schedule = types.StringPointerValue(rule.Schedule)
+	m.InPipe = types.StringPointerValue(rule.InPipe)
+	m.OutPipe = types.StringPointerValue(rule.OutPipe)
+	m.DefaultQueue = types.StringPointerValue(rule.DefaultQueue)
+	m.AckQueue = types.StringPointerValue(rule.AckQueue)
+	m.Gateway = types.StringPointerValue(rule.Gateway)
+	m.ICMPTypes = stringValues(rule.ICMPTypes)
+	m.StateType = types.StringValue(rule.StateType)
+	m.TCPFlagsAny = types.BoolValue(rule.TCPFlagsAny)
+	m.TCPFlagsOutOf = stringValues(rule.TCPFlagsOutOf)
+	m.TCPFlagsSet = stringValues(rule.TCPFlagsSet)
+}
+
+func (r *FirewallRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rule"
+}
+
+func (r *FirewallRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := firewallRuleAttributes()
+	attributes["type"] = schema.StringAttribute{
+		MarkdownDescription: "Rule type",
+		Required:            true,
+		Validators: []validator.String{stringvalidator.OneOf(
+			string(pfsense_rest_v2.FirewallRuleTypePass),
+			string(pfsense_rest_v2.FirewallRuleTypeBlock),
+			string(pfsense_rest_v2.FirewallRuleTypeReject),
+		)},
+	}
+	attributes["interfaces"] = schema.ListAttribute{
+		MarkdownDescription: "Interface this rule applies to, as a single-element list. Use `pfsense-v2_firewall_floating_rule` for rules on several interfaces.",
+		ElementType:         types.StringType,
+		Required:            true,
+		Validators:          []validator.List{listvalidator.SizeBetween(1, 1)},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Firewall rule on an interface. Can be imported by pfSense ID, " +
+			"by `tracker:<tracker>` or by `description:<description>`.",
+
+		Attributes: attributes,
+	}
+}
+
+// firewallRuleAttributes returns the schema attributes shared by interface
+// and floating rules. Each resource adds its own `type` and `interfaces`.
+func firewallRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "pfSense ID of the rule. This is the rule's position in the configuration and may change when other rules are removed; the provider locates the rule by `tracker` and records the new ID.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"tracker": schema.Int64Attribute{
+			MarkdownDescription: "Tracker ID assigned by pfSense when the rule is created. Unlike `id`, this never changes.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"disabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the rule is disabled",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"address_family": schema.StringAttribute{
+			MarkdownDescription: "Address family (IPv4/IPv6)",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(pfsense_rest_v2.FirewallRuleIpprotocolInet)),
+			Validators: []validator.String{stringvalidator.OneOf(
+				string(pfsense_rest_v2.FirewallRuleIpprotocolInet),   // IPv4
+				string(pfsense_rest_v2.FirewallRuleIpprotocolInet6),  // IPv6
+				string(pfsense_rest_v2.FirewallRuleIpprotocolInet46), // IPv4 and IPv6
+			)},
+		},
+		"log": schema.BoolAttribute{
+			MarkdownDescription: "Whether to log packets matching this rule",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Rule description",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"kill_states_on_change": schema.BoolAttribute{
+			MarkdownDescription: "Whether to kill the firewall states the rule may have decided on after creating or changing it, so the new rule also applies to established connections. " +
+				"This kills the states on the rule's interfaces with the rule's protocol, source and destination addresses when these are single IP addresses, and destination port. " +
+				"Rules matching any address and port only produce a warning, since they would cut every connection on the interface, and the provider's own connection to the API is never killed. " +
+				"Killed connections have to be re-established, and are then subject to the current rules.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol. Leave unset to match any protocol. Supported values: ah, carp, esp, gre, icmp, igmp, ipv6, ospf, pfsync, pim, tcp, tcp/udp, udp.",
+			Optional:            true,
+			Validators: []validator.String{stringvalidator.OneOf(
+				string(pfsense_rest_v2.FirewallRuleProtocolAh),
+				string(pfsense_rest_v2.FirewallRuleProtocolCarp),
+				string(pfsense_rest_v2.FirewallRuleProtocolEsp),
+				string(pfsense_rest_v2.FirewallRuleProtocolGre),
+				string(pfsense_rest_v2.FirewallRuleProtocolIcmp),
+				string(pfsense_rest_v2.FirewallRuleProtocolIgmp),
+				string(pfsense_rest_v2.FirewallRuleProtocolIpv6),
+				string(pfsense_rest_v2.FirewallRuleProtocolOspf),
+				string(pfsense_rest_v2.FirewallRuleProtocolPfsync),
+				string(pfsense_rest_v2.FirewallRuleProtocolPim),
+				string(pfsense_rest_v2.FirewallRuleProtocolTcp),
+				string(pfsense_rest_v2.FirewallRuleProtocolTcpudp),
+				string(pfsense_rest_v2.FirewallRuleProtocolUdp),
+			)},
+		},
+		"source": schema.StringAttribute{
+			MarkdownDescription: "The source address this rule applies to. Valid value options are: an existing interface, an IP address, a subnet CIDR, an existing alias, `any`, `(self)`, `l2tp`, `pppoe`. The context of this address can be inverted by prefixing the value with `!`. For interface values, the `:ip` modifier can be appended to the value to use the interface's IP address instead of its entire subnet.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("any"),
+			Validators:          []validator.String{AddressExpressionValidator{}},
+		},
+		"source_port": schema.StringAttribute{
+			MarkdownDescription: "The source port this rule applies to. Leave unset to allow any source port. Valid options are: a TCP/UDP port number, a TCP/UDP port range separated by `:`, an existing port type firewall alias. This field is only available when the following conditions are met: protocol must be one of [ tcp, udp, tcp/udp ].",
+			Optional:            true,
+			Validators:          []validator.String{PortRangeOrNullValidator{}},
+		},
+		"destination": schema.StringAttribute{
+			MarkdownDescription: "The destination address this rule applies to. Valid value options are: an existing interface, an IP address, a subnet CIDR, an existing alias, `any`, `(self)`, `l2tp`, `pppoe`. The context of this address can be inverted by prefixing the value with `!`. For interface values, the `:ip` modifier can be appended to the value to use the interface's IP address instead of its entire subnet.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("any"),
+			Validators:          []validator.String{AddressExpressionValidator{}},
+		},
+		"destination_port": schema.StringAttribute{
+			MarkdownDescription: "The destination port this rule applies to. Leave unset to allow any destination port. Valid options are: a TCP/UDP port number, a TCP/UDP port range separated by `:`, an existing port type firewall alias. This field is only available when the following conditions are met: protocol must be one of [ tcp, udp, tcp/udp ].",
+			Optional:            true,
+			Validators:          []validator.String{PortRangeOrNullValidator{}},
+		},
+		"sched": schema.StringAttribute{
+			MarkdownDescription: "Name of the `pfsense-v2_firewall_schedule` during which the rule is active. Leave unset for a rule that is always active.",
+			Optional:            true,
+		},
+		"dnpipe": schema.StringAttribute{
+			MarkdownDescription: "Name of the `pfsense-v2_traffic_limiter`, or of one of its queues, that limits traffic entering the interface. Leave unset for no limit.",
+			Optional:            true,
+		},
+		"pdnpipe": schema.StringAttribute{
+			MarkdownDescription: "Name of the `pfsense-v2_traffic_limiter`, or of one of its queues, that limits traffic leaving the interface. Requires `dnpipe`; leave unset to use `dnpipe` in both directions.",
+			Optional:            true,
+		},
+		"defaultqueue": schema.StringAttribute{
+			MarkdownDescription: "Name of the `pfsense-v2_traffic_shaper` queue matching traffic is assigned to. Leave unset to use the shaper's default queue.",
+			Optional:            true,
+		},
+		"ackqueue": schema.StringAttribute{
+			MarkdownDescription: "Name of the `pfsense-v2_traffic_shaper` queue TCP acknowledgements of matching traffic are assigned to. Requires `defaultqueue`; leave unset to use `defaultqueue`.",
+			Optional:            true,
+		},
+		"gateway": schema.StringAttribute{
+			MarkdownDescription: "Name of the gateway or gateway group matching traffic is routed through, for example `WAN2_DHCP`. Leave unset to use the routing table. The name is checked against the gateways on the device when planning.",
+			Optional:            true,
+		},
+		"icmptype": schema.ListAttribute{
+			MarkdownDescription: "ICMP types to match when `protocol` is icmp, for example `echoreq` or `unreach`. Leave unset to match any ICMP type.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			Validators:          []validator.List{listvalidator.ValueStringsAre(stringvalidator.OneOf(icmpTypes...))},
+		},
+		"statetype": schema.StringAttribute{
+			MarkdownDescription: "State tracking for matching connections. Supported values: `keep state`, `sloppy state`, `synproxy state` (tcp only), `none`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(pfsense_rest_v2.FirewallRuleStatetypeKeepState)),
+			Validators: []validator.String{stringvalidator.OneOf(
+				string(pfsense_rest_v2.FirewallRuleStatetypeKeepState),
+				string(pfsense_rest_v2.FirewallRuleStatetypeSloppyState),
+				string(pfsense_rest_v2.FirewallRuleStatetypeSynproxyState),
+				string(pfsense_rest_v2.FirewallRuleStatetypeNone),
+			)},
+		},
+		"tcp_flags_any": schema.BoolAttribute{
+			MarkdownDescription: "Whether to match TCP packets with any flags. By default pfSense only creates state for packets with SYN set out of SYN and ACK.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"tcp_flags_out_of": schema.ListAttribute{
+			MarkdownDescription: "TCP flags to check. Supported values: " + strings.Join(tcpFlags, ", ") + ". Leave unset for the pfSense default.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			Validators:          []validator.List{listvalidator.ValueStringsAre(stringvalidator.OneOf(tcpFlags...))},
+		},
+		"tcp_flags_set": schema.ListAttribute{
+			MarkdownDescription: "TCP flags, out of `tcp_flags_out_of`, that must be set for a packet to match.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			Validators:          []validator.List{listvalidator.ValueStringsAre(stringvalidator.OneOf(tcpFlags...))},
+		},
+	}
+}
+
+func (r *FirewallRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateFirewallRule(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (r *FirewallRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the rule is being destroyed
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	checkGateway(ctx, r.client, req.Plan, &resp.Diagnostics)
+
+	if !r.client.ValidateReferences {
+		return
+	}
+
+	checkReferences(ctx, r.client, req.Plan, referenceAttributes{
+		Addresses:      []string{"source", "destination"},
+		Ports:          []string{"source_port", "destination_port"},
+		Interfaces:     []string{"interfaces"},
+		InterfaceLists: true,
+	}, &resp.Diagnostics)
+}
+
+func (r *FirewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.CreateFirewallRule(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create firewall rule, got error: %s", err))
+		return
+	}
+	data.fromDomain(rule)
+
+	tflog.Trace(ctx, "created a firewall rule", map[string]interface{}{"id": rule.ID, "tracker": rule.Tracker})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the rule
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+		return
+	}
+
+	if data.KillStatesOnChange.ValueBool() {
+		killStatesAfterChange(ctx, r.client, rule, &resp.Diagnostics)
+	}
+}
+
+func (r *FirewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, drift, err := r.client.ResolveFirewallRule(int(data.ID.ValueInt64()), int(data.Tracker.ValueInt64()))
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rule, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(rule)
+	// The option is not stored on pfSense, so imported rules start without it.
+	if data.KillStatesOnChange.IsNull() {
+		data.KillStatesOnChange = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FirewallRuleResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "firewall rule", func() (*pfsense_rest_v2.PFSenseFirewallRule, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveFirewallRule(int(state.ID.ValueInt64()), int(state.Tracker.ValueInt64()))
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	rule, err := r.client.UpdateFirewallRule(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall rule, got error: %s", err))
+		return
+	}
+	data.fromDomain(rule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+		return
+	}
+
+	if data.KillStatesOnChange.ValueBool() {
+		killStatesAfterChange(ctx, r.client, rule, &resp.Diagnostics)
+	}
+}
+
+func (r *FirewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "firewall rule", func() (*pfsense_rest_v2.PFSenseFirewallRule, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveFirewallRule(int(data.ID.ValueInt64()), int(data.Tracker.ValueInt64()))
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteFirewallRule(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall rule, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *FirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	key, value := importKey(req.ID, "tracker", "description")
+
+	var id int
+	switch key {
+	case "tracker", "description":
+		rules, err := r.client.GetFirewallRules()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rules, got error: %s", err))
+			return
+		}
+		rule, err := findUnique(rules, func(rule *pfsense_rest_v2.PFSenseFirewallRule) bool {
+			if rule.Floating {
+				return false
+			}
+			if key == "tracker" {
+				return strconv.Itoa(rule.Tracker) == value
+			}
+			return rule.Description == value
+		}, fmt.Sprintf("%s %q", key, value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Firewall Rule", err.Error())
+			return
+		}
+		id = rule.ID
+	default:
+		var err error
+		id, err = strconv.Atoi(value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected a numeric pfSense ID, `tracker:<tracker>` or `description:<description>`, got: %q", req.ID),
+			)
+			return
+		}
+
+		// Both resources share the rules endpoint, so make sure the rule is of the right kind
+		rule, err := r.client.GetFirewallRule(id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rule, got error: %s", err))
+			return
+		}
+		if rule.Floating {
+			resp.Diagnostics.AddError("Unable to Import Firewall Rule", fmt.Sprintf("pfSense ID %d is a floating rule; import it as a pfsense-v2_firewall_floating_rule.", id))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
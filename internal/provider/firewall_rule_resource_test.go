@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFirewallRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallRuleResourceConfig("443"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_rule.test",
+						tfjsonpath.New("destination_port"),
+						knownvalue.StringExact("443"),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_rule.test",
+						tfjsonpath.New("source_port"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// ImportState testing by pfSense ID
+			{
+				ResourceName:      "pfsense-v2_firewall_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by tracker
+			{
+				ResourceName:      "pfsense-v2_firewall_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "tracker:" + s.RootModule().Resources["pfsense-v2_firewall_rule.test"].Primary.Attributes["tracker"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallRuleResourceConfig("8443"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_rule.test",
+						tfjsonpath.New("destination_port"),
+						knownvalue.StringExact("8443"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFirewallRuleResourceConfig(destinationPort string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_firewall_rule" "test" {
+  type             = "pass"
+  interfaces       = ["lan"]
+  protocol         = "tcp"
+  destination_port = %[1]q
+  description      = "terraform acceptance test"
+}
+`, destinationPort)
+}
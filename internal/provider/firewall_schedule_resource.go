@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallScheduleResource{}
+var _ resource.ResourceWithImportState = &FirewallScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallScheduleResource{}
+
+func NewFirewallScheduleResource() resource.Resource {
+	return &FirewallScheduleResource{}
+}
+
+// FirewallScheduleResource defines the resource implementation.
+type FirewallScheduleResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// FirewallScheduleResourceModel describes the resource data model.
+type FirewallScheduleResourceModel struct {
+	ID          types.Int64                      `tfsdk:"id"`
+	Name        types.String                     `tfsdk:"name"`
+	Description types.String                     `tfsdk:"description"`
+	TimeRanges  []FirewallScheduleTimeRangeModel `tfsdk:"time_ranges"`
+}
+
+// FirewallScheduleTimeRangeModel describes one entry of time_ranges.
+type FirewallScheduleTimeRangeModel struct {
+	Weekdays    []types.Int64 `tfsdk:"weekdays"`
+	Months      []types.Int64 `tfsdk:"months"`
+	Days        []types.Int64 `tfsdk:"days"`
+	Hours       types.String  `tfsdk:"hours"`
+	Description types.String  `tfsdk:"description"`
+}
+
+func (m *FirewallScheduleResourceModel) toDomain() *pfsense_rest_v2.PFSenseFirewallSchedule {
+	schedule := &pfsense_rest_v2.PFSenseFirewallSchedule{
+		ID:          int(m.ID.ValueInt64()),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		TimeRanges:  []pfsense_rest_v2.PFSenseFirewallScheduleTimeRange{},
+	}
+	for _, r := range m.TimeRanges {
+		schedule.TimeRanges = append(schedule.TimeRanges, pfsense_rest_v2.PFSenseFirewallScheduleTimeRange{
+			Weekdays:    intsFromValues(r.Weekdays),
+			Months:      intsFromValues(r.Months),
+			Days:        intsFromValues(r.Days),
+			Hours:       r.Hours.ValueString(),
+			Description: r.Description.ValueString(),
+		})
+	}
+	return schedule
+}
+
+func (m *FirewallScheduleResourceModel) fromDomain(schedule *pfsense_rest_v2.PFSenseFirewallSchedule) {
+	m.ID = types.Int64Value(int64(schedule.ID))
+	m.Name = types.StringValue(schedule.Name)
+	m.Description = types.StringValue(schedule.Description)
+	m.TimeRanges = []FirewallScheduleTimeRangeModel{}
+	for _, r := range schedule.TimeRanges {
+		m.TimeRanges = append(m.TimeRanges, FirewallScheduleTimeRangeModel{
+			Weekdays:    int64Values(r.Weekdays),
+			Months:      int64Values(r.Months),
+			Days:        int64Values(r.Days),
+			Hours:       types.StringValue(r.Hours),
+			Description: types.StringValue(r.Description),
+		})
+	}
+}
+
+func (r *FirewallScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_schedule"
+}
+
+func (r *FirewallScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptyList := listdefault.StaticValue(types.ListValueMust(types.Int64Type, []attr.Value{}))
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Firewall schedule. Rules refer to a schedule by name in their `sched` attribute and only match while one of its time ranges is active. Can be imported by pfSense ID or by schedule name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the schedule. This is the schedule's position in the configuration and may change when other schedules are removed; the provider locates the schedule by `name` and records the new ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Schedule name, as referenced by the `sched` attribute of rules",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Schedule description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"time_ranges": schema.ListNestedAttribute{
+				MarkdownDescription: "Times the schedule is active. Each range applies either on `weekdays` or on the dates given by `months` and `days`.",
+				Required:            true,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"weekdays": schema.ListAttribute{
+							MarkdownDescription: "Days of the week the range repeats on, from 1 (Monday) to 7 (Sunday)",
+							ElementType:         types.Int64Type,
+							Optional:            true,
+							Computed:            true,
+							Default:             emptyList,
+							Validators:          []validator.List{listvalidator.ValueInt64sAre(int64validator.Between(1, 7))},
+						},
+						"months": schema.ListAttribute{
+							MarkdownDescription: "Month of each date the range applies on, from 1 to 12. Paired by position with `days`.",
+							ElementType:         types.Int64Type,
+							Optional:            true,
+							Computed:            true,
+							Default:             emptyList,
+							Validators:          []validator.List{listvalidator.ValueInt64sAre(int64validator.Between(1, 12))},
+						},
+						"days": schema.ListAttribute{
+							MarkdownDescription: "Day of the month of each date the range applies on, from 1 to 31. Paired by position with `months`.",
+							ElementType:         types.Int64Type,
+							Optional:            true,
+							Computed:            true,
+							Default:             emptyList,
+							Validators:          []validator.List{listvalidator.ValueInt64sAre(int64validator.Between(1, 31))},
+						},
+						"hours": schema.StringAttribute{
+							MarkdownDescription: "Time of day the range is active, as `start-end` in 24-hour time, e.g. `9:00-17:00`",
+							Required:            true,
+							Validators: []validator.String{stringvalidator.RegexMatches(
+								regexp.MustCompile(`^([01]?[0-9]|2[0-3]):[0-5][0-9]-([01]?[0-9]|2[0-3]):[0-5][0-9]$`),
+								"must be a time of day range such as `9:00-17:00`",
+							)},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Time range description",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *FirewallScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var timeRanges types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("time_ranges"), &timeRanges)...)
+
+	if resp.Diagnostics.HasError() || timeRanges.IsNull() || timeRanges.IsUnknown() {
+		return
+	}
+
+	for i, element := range timeRanges.Elements() {
+		timeRange, ok := element.(types.Object)
+		if !ok || timeRange.IsNull() || timeRange.IsUnknown() {
+			continue
+		}
+		attrs := timeRange.Attributes()
+		weekdays, weekdaysKnown := knownListLength(attrs["weekdays"])
+		months, monthsKnown := knownListLength(attrs["months"])
+		days, daysKnown := knownListLength(attrs["days"])
+		rangePath := path.Root("time_ranges").AtListIndex(i)
+
+		if weekdaysKnown && monthsKnown && daysKnown && (weekdays > 0) == (months > 0 || days > 0) {
+			resp.Diagnostics.AddAttributeError(
+				rangePath,
+				"Invalid Time Range",
+				"Each time range must set either `weekdays`, or `months` and `days`, but not both.",
+			)
+		}
+		if monthsKnown && daysKnown && months != days {
+			resp.Diagnostics.AddAttributeError(
+				rangePath.AtName("days"),
+				"Invalid Time Range",
+				fmt.Sprintf("`months` and `days` pair up by position and must be the same length, got %d months and %d days.", months, days),
+			)
+		}
+	}
+}
+
+// knownListLength returns the number of elements of a list value, and whether
+// that number is known. Null lists have no elements.
+func knownListLength(value attr.Value) (int, bool) {
+	list, ok := value.(types.List)
+	if !ok || list.IsUnknown() {
+		return 0, false
+	}
+	return len(list.Elements()), true
+}
+
+func (r *FirewallScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallScheduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.CreateFirewallSchedule(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create firewall schedule, got error: %s", err))
+		return
+	}
+	data.fromDomain(schedule)
+
+	tflog.Trace(ctx, "created a firewall schedule", map[string]interface{}{"id": schedule.ID, "name": schedule.Name})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the schedule
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *FirewallScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, drift, err := r.client.ResolveFirewallSchedule(int(data.ID.ValueInt64()), data.Name.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall schedule, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(schedule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FirewallScheduleResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "firewall schedule", func() (*pfsense_rest_v2.PFSenseFirewallSchedule, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveFirewallSchedule(int(state.ID.ValueInt64()), state.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	schedule, err := r.client.UpdateFirewallSchedule(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall schedule, got error: %s", err))
+		return
+	}
+	data.fromDomain(schedule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *FirewallScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "firewall schedule", func() (*pfsense_rest_v2.PFSenseFirewallSchedule, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveFirewallSchedule(int(data.ID.ValueInt64()), data.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteFirewallSchedule(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall schedule, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *FirewallScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Schedule names cannot be purely numeric, so anything that is not a number is a name.
+	_, value := importKey(req.ID, "name")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		schedules, err := r.client.GetFirewallSchedules()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall schedules, got error: %s", err))
+			return
+		}
+		schedule, err := findUnique(schedules, func(schedule *pfsense_rest_v2.PFSenseFirewallSchedule) bool {
+			return schedule.Name == value
+		}, fmt.Sprintf("name %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Firewall Schedule", err.Error())
+			return
+		}
+		id = schedule.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
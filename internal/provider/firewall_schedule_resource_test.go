@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFirewallScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, with a rule attached to the schedule
+			{
+				Config: testAccFirewallScheduleResourceConfig("8:00-18:00"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_schedule.test",
+						tfjsonpath.New("time_ranges").AtSliceIndex(0).AtMapKey("hours"),
+						knownvalue.StringExact("8:00-18:00"),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_rule.test",
+						tfjsonpath.New("sched"),
+						knownvalue.StringExact("tf_acc_test"),
+					),
+				},
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "pfsense-v2_firewall_schedule.test",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_test",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallScheduleResourceConfig("9:00-17:00"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_schedule.test",
+						tfjsonpath.New("time_ranges").AtSliceIndex(0).AtMapKey("hours"),
+						knownvalue.StringExact("9:00-17:00"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFirewallScheduleResource_InvalidTimeRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "pfsense-v2_firewall_schedule" "test" {
+  name        = "tf_acc_test"
+  time_ranges = [{ weekdays = [1], months = [1], days = [1], hours = "8:00-18:00" }]
+}
+`,
+				ExpectError: regexp.MustCompile("either `weekdays`, or `months` and `days`"),
+			},
+		},
+	})
+}
+
+func testAccFirewallScheduleResourceConfig(hours string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_firewall_schedule" "test" {
+  name        = "tf_acc_test"
+  time_ranges = [{ weekdays = [1, 2, 3, 4, 5], hours = %[1]q }]
+}
+
+resource "pfsense-v2_firewall_rule" "test" {
+  type        = "pass"
+  interfaces  = ["lan"]
+  description = "tf_acc_test scheduled rule"
+  sched       = pfsense-v2_firewall_schedule.test.name
+}
+`, hours)
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// interfaceDevice returns the device name pfSense reports states on for an
+// interface ID, e.g. igb1 for lan.
+func interfaceDevice(client *pfsense_rest_v2.PFSenseClientV2, id string) (string, error) {
+	iface, err := client.GetInterface(id)
+	if err != nil {
+		return "", fmt.Errorf("reading interface %s: %w", id, err)
+	}
+	return iface.Port, nil
+}
+
+// ruleStateAddress returns the address of a rule's source or destination
+// when it is a single, non-inverted address. ok is false for anything else.
+func ruleStateAddress(val string) (netip.Addr, bool) {
+	expr, err := ParseAddressExpression(val)
+	if err != nil || expr.Kind != AddressKindAddress || expr.Inverted {
+		return netip.Addr{}, false
+	}
+	return netip.MustParseAddr(expr.Value), true
+}
+
+// ruleStateSelector selects the states a rule may have decided on, on one of
+// its interfaces.
+type ruleStateSelector struct {
+	// protocols is empty when the rule matches any protocol.
+	protocols []string
+	// source and destination are invalid when the rule matches more than a
+	// single address.
+	source      netip.Addr
+	destination netip.Addr
+	// destinationPorts is Any when the rule matches any port or a port alias.
+	destinationPorts PortRange
+}
+
+// newRuleStateSelector returns the selector for rule.
+func newRuleStateSelector(rule *pfsense_rest_v2.PFSenseFirewallRule) ruleStateSelector {
+	var selector ruleStateSelector
+	if rule.Protocol != nil {
+		if *rule.Protocol == "tcp/udp" {
+			selector.protocols = []string{"tcp", "udp"}
+		} else {
+			selector.protocols = []string{*rule.Protocol}
+		}
+	}
+	selector.source, _ = ruleStateAddress(rule.Source)
+	selector.destination, _ = ruleStateAddress(rule.Destination)
+	selector.destinationPorts = PortRange{Any: true}
+	if rule.DestinationPort != nil {
+		if ports, err := ParsePortRange(*rule.DestinationPort); err == nil {
+			selector.destinationPorts = ports
+		}
+	}
+	return selector
+}
+
+// narrow reports whether the selector picks out particular connections. One
+// that only has the interface and protocol to go by would kill every
+// connection on the interface, so its states are left alone.
+func (s ruleStateSelector) narrow() bool {
+	return s.source.IsValid() || s.destination.IsValid() || !s.destinationPorts.Any
+}
+
+// filter returns the filter listing the candidate states on device. Address
+// filters only match text, so the states it lists are checked with matches.
+func (s ruleStateSelector) filter(device string) pfsense_rest_v2.PFSenseFirewallStateFilter {
+	filter := pfsense_rest_v2.PFSenseFirewallStateFilter{Interface: device}
+	if len(s.protocols) == 1 {
+		filter.Protocol = s.protocols[0]
+	}
+	if s.source.IsValid() {
+		filter.Source = s.source.String()
+	}
+	if s.destination.IsValid() {
+		filter.Destination = s.destination.String()
+	}
+	return filter
+}
+
+// matches reports whether state is one the rule may have decided on.
+func (s ruleStateSelector) matches(state *pfsense_rest_v2.PFSenseFirewallState) bool {
+	if len(s.protocols) > 0 && !slices.Contains(s.protocols, state.Protocol) {
+		return false
+	}
+	source, ok := state.SourceAddress()
+	if s.source.IsValid() && (!ok || source.Addr().Unmap() != s.source) {
+		return false
+	}
+	destination, ok := state.DestinationAddress()
+	if s.destination.IsValid() && (!ok || destination.Addr().Unmap() != s.destination) {
+		return false
+	}
+	if !s.destinationPorts.Any {
+		if !ok || int(destination.Port()) < s.destinationPorts.From || int(destination.Port()) > s.destinationPorts.To {
+			return false
+		}
+	}
+	return true
+}
+
+// errRuleTooBroad is returned by killRuleStates for rules that only select
+// states by interface and protocol.
+var errRuleTooBroad = errors.New("the rule matches any address and port, so killing its states would cut every connection on its interfaces")
+
+// killRuleStates kills the states a rule may have decided on, so a changed
+// rule also applies to connections established before the change. It
+// returns how many states were killed. States of the provider's own
+// connection to the API are never killed.
+func killRuleStates(client *pfsense_rest_v2.PFSenseClientV2, rule *pfsense_rest_v2.PFSenseFirewallRule) (int, error) {
+	selector := newRuleStateSelector(rule)
+	if !selector.narrow() {
+		return 0, errRuleTooBroad
+	}
+
+	killed := 0
+	for _, id := range rule.Interfaces {
+		device, err := interfaceDevice(client, id)
+		if err != nil {
+			return killed, err
+		}
+		states, err := client.GetFirewallStates(selector.filter(device))
+		if err != nil {
+			return killed, err
+		}
+		n, err := client.KillFirewallStates(slices.DeleteFunc(states, func(state *pfsense_rest_v2.PFSenseFirewallState) bool {
+			return !selector.matches(state)
+		}))
+		killed += n
+		if err != nil {
+			return killed, err
+		}
+	}
+	return killed, nil
+}
+
+// killStatesAfterChange runs killRuleStates for a rule that has just been
+// created or updated. The rule change itself has been applied at this point,
+// so failures are reported as warnings.
+func killStatesAfterChange(ctx context.Context, client *pfsense_rest_v2.PFSenseClientV2, rule *pfsense_rest_v2.PFSenseFirewallRule, diags *diag.Diagnostics) {
+	killed, err := killRuleStates(client, rule)
+	if errors.Is(err, errRuleTooBroad) {
+		diags.AddWarning(
+			"Firewall States Not Killed",
+			fmt.Sprintf("The rule change was applied, but no states were killed: %s. "+
+				"Established connections are still governed by the previous rule until they close or the states are reset.", err),
+		)
+		return
+	}
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Kill Firewall States",
+			fmt.Sprintf("The rule change was applied, but killing the states it affects failed after %d states, got error: %s. "+
+				"Established connections are still governed by the previous rule.", killed, err),
+		)
+		return
+	}
+	tflog.Debug(ctx, "killed firewall states after rule change", map[string]interface{}{"tracker": rule.Tracker, "killed": killed})
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FirewallStatesDataSource{}
+
+func NewFirewallStatesDataSource() datasource.DataSource {
+	return &FirewallStatesDataSource{}
+}
+
+// FirewallStatesDataSource defines the data source implementation.
+type FirewallStatesDataSource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// FirewallStatesDataSourceModel describes the data source data model.
+type FirewallStatesDataSourceModel struct {
+	Interface   types.String         `tfsdk:"interface"`
+	Source      types.String         `tfsdk:"source"`
+	Destination types.String         `tfsdk:"destination"`
+	States      []FirewallStateModel `tfsdk:"states"`
+}
+
+// FirewallStateModel describes one entry of states.
+type FirewallStateModel struct {
+	Interface   types.String `tfsdk:"interface"`
+	Protocol    types.String `tfsdk:"protocol"`
+	Direction   types.String `tfsdk:"direction"`
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	State       types.String `tfsdk:"state"`
+	Age         types.String `tfsdk:"age"`
+	ExpiresIn   types.String `tfsdk:"expires_in"`
+	PacketsIn   types.Int64  `tfsdk:"packets_in"`
+	PacketsOut  types.Int64  `tfsdk:"packets_out"`
+	BytesIn     types.Int64  `tfsdk:"bytes_in"`
+	BytesOut    types.Int64  `tfsdk:"bytes_out"`
+}
+
+func (d *FirewallStatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_states"
+}
+
+func (d *FirewallStatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Entries of the firewall state table, optionally filtered. Filters are applied by pfSense, " +
+			"and the table is read in pages, so large state tables can be searched.",
+
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Only return states created on this interface, given by its pfSense ID (e.g. `lan`)",
+				Optional:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Only return states whose source address and port contain this text. " +
+					"IPv4 states are reported as `address:port` and IPv6 states as `address[port]`, so `192.168.1.10:` matches a single IPv4 host.",
+				Optional: true,
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "Only return states whose destination address and port contain this text, in the format described for `source`",
+				Optional:            true,
+			},
+			"states": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching states",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Device the state was created on (e.g. `igb1`)",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol",
+							Computed:            true,
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Direction of the packet that created the state",
+							Computed:            true,
+						},
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Source address and port",
+							Computed:            true,
+						},
+						"destination": schema.StringAttribute{
+							MarkdownDescription: "Destination address and port",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Connection state, e.g. `ESTABLISHED:ESTABLISHED`",
+							Computed:            true,
+						},
+						"age": schema.StringAttribute{
+							MarkdownDescription: "Time since the state was created",
+							Computed:            true,
+						},
+						"expires_in": schema.StringAttribute{
+							MarkdownDescription: "Time until the state expires without further traffic",
+							Computed:            true,
+						},
+						"packets_in": schema.Int64Attribute{
+							MarkdownDescription: "Packets received",
+							Computed:            true,
+						},
+						"packets_out": schema.Int64Attribute{
+							MarkdownDescription: "Packets sent",
+							Computed:            true,
+						},
+						"bytes_in": schema.Int64Attribute{
+							MarkdownDescription: "Bytes received",
+							Computed:            true,
+						},
+						"bytes_out": schema.Int64Attribute{
+							MarkdownDescription: "Bytes sent",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallStatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallStatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallStatesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := pfsense_rest_v2.PFSenseFirewallStateFilter{
+		Source:      data.Source.ValueString(),
+		Destination: data.Destination.ValueString(),
+	}
+	if !data.Interface.IsNull() {
+		device, err := interfaceDevice(d.client, data.Interface.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall states, got error: %s", err))
+			return
+		}
+		filter.Interface = device
+	}
+
+	states, err := d.client.GetFirewallStates(filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall states, got error: %s", err))
+		return
+	}
+
+	data.States = []FirewallStateModel{}
+	for _, s := range states {
+		data.States = append(data.States, FirewallStateModel{
+			Interface:   types.StringValue(s.Interface),
+			Protocol:    types.StringValue(s.Protocol),
+			Direction:   types.StringValue(s.Direction),
+			Source:      types.StringValue(s.Source),
+			Destination: types.StringValue(s.Destination),
+			State:       types.StringValue(s.State),
+			Age:         types.StringValue(s.Age),
+			ExpiresIn:   types.StringValue(s.ExpiresIn),
+			PacketsIn:   types.Int64Value(int64(s.PacketsIn)),
+			PacketsOut:  types.Int64Value(int64(s.PacketsOut)),
+			BytesIn:     types.Int64Value(int64(s.BytesIn)),
+			BytesOut:    types.Int64Value(int64(s.BytesOut)),
+		})
+	}
+
+	tflog.Trace(ctx, "read firewall states", map[string]interface{}{"count": len(data.States)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
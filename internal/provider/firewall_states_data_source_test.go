@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFirewallStatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing. The provider's own connection to pfSense has a
+			// state, so the table is never empty.
+			{
+				Config: `
+data "pfsense-v2_firewall_states" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.pfsense-v2_firewall_states.test",
+						tfjsonpath.New("states"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// Filtering on an address that cannot occur returns no states
+			{
+				Config: `
+data "pfsense-v2_firewall_states" "test" {
+  source = "192.0.2.255:"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.pfsense-v2_firewall_states.test",
+						tfjsonpath.New("states"),
+						knownvalue.ListSizeExact(0),
+					),
+				},
+			},
+		},
+	})
+}
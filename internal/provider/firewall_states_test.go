@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+)
+
+func TestRuleStateAddress(t *testing.T) {
+	cases := []struct {
+		val  string
+		want string
+		ok   bool
+	}{
+		{"192.168.1.10", "192.168.1.10", true},
+		{"fd00::1", "fd00::1", true},
+		{"!192.168.1.10", "", false},
+		{"192.168.1.0/24", "", false},
+		{"lan", "", false},
+		{"any", "", false},
+	}
+	for _, c := range cases {
+		got, ok := ruleStateAddress(c.val)
+		if ok != c.ok || (ok && got.String() != c.want) {
+			t.Errorf("ruleStateAddress(%q) = %v, %v, want %q, %v", c.val, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestRuleStateSelector_Matches(t *testing.T) {
+	protocol := "tcp"
+	port := "443"
+	selector := newRuleStateSelector(&pfsense_rest_v2.PFSenseFirewallRule{
+		Protocol:        &protocol,
+		Source:          "1.1.1.1",
+		Destination:     "any",
+		DestinationPort: &port,
+	})
+	if !selector.narrow() {
+		t.Fatal("expected a rule with a single source address to be narrow")
+	}
+
+	cases := []struct {
+		state *pfsense_rest_v2.PFSenseFirewallState
+		want  bool
+	}{
+		{&pfsense_rest_v2.PFSenseFirewallState{Protocol: "tcp", Source: "1.1.1.1:51234", Destination: "10.0.0.5:443"}, true},
+		// The address filter only matches text, which these also contain.
+		{&pfsense_rest_v2.PFSenseFirewallState{Protocol: "tcp", Source: "11.1.1.1:51234", Destination: "10.0.0.5:443"}, false},
+		{&pfsense_rest_v2.PFSenseFirewallState{Protocol: "tcp", Source: "101.1.1.1:51234", Destination: "10.0.0.5:443"}, false},
+		{&pfsense_rest_v2.PFSenseFirewallState{Protocol: "udp", Source: "1.1.1.1:51234", Destination: "10.0.0.5:443"}, false},
+		{&pfsense_rest_v2.PFSenseFirewallState{Protocol: "tcp", Source: "1.1.1.1:51234", Destination: "10.0.0.5:22"}, false},
+	}
+	for _, c := range cases {
+		if got := selector.matches(c.state); got != c.want {
+			t.Errorf("matches(%s %s -> %s) = %v, want %v", c.state.Protocol, c.state.Source, c.state.Destination, got, c.want)
+		}
+	}
+}
+
+func TestRuleStateSelector_Narrow(t *testing.T) {
+	protocol := "tcp"
+	selector := newRuleStateSelector(&pfsense_rest_v2.PFSenseFirewallRule{Protocol: &protocol, Source: "any", Destination: "lan"})
+	if selector.narrow() {
+		t.Error("expected a rule matching any address and port not to be narrow")
+	}
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupResource{}
+var _ resource.ResourceWithImportState = &GroupResource{}
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+// GroupResource defines the resource implementation.
+type GroupResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// GroupResourceModel describes the resource data model.
+type GroupResourceModel struct {
+	ID          types.Int64    `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Scope       types.String   `tfsdk:"scope"`
+	Members     []types.String `tfsdk:"members"`
+	Privileges  []types.String `tfsdk:"privileges"`
+}
+
+// toDomain builds the group with the given members, which are the current
+// ones when the group does not manage membership.
+func (m *GroupResourceModel) toDomain(members []string) *pfsense_rest_v2.PFSenseUserGroup {
+	if m.Members != nil {
+		members = stringsFromValues(m.Members)
+	}
+	return &pfsense_rest_v2.PFSenseUserGroup{
+		ID:          int(m.ID.ValueInt64()),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Scope:       m.Scope.ValueString(),
+		Members:     members,
+		Privileges:  stringsFromValues(m.Privileges),
+	}
+}
+
+func (m *GroupResourceModel) fromDomain(group *pfsense_rest_v2.PFSenseUserGroup) {
+	m.ID = types.Int64Value(int64(group.ID))
+	m.Name = types.StringValue(group.Name)
+	m.Description = types.StringValue(group.Description)
+	m.Scope = types.StringValue(group.Scope)
+	if m.Members != nil {
+		m.Members = stringValues(group.Members)
+	}
+	m.Privileges = stringValues(group.Privileges)
+}
+
+func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User group. Members receive the group's privileges. Can be imported by pfSense ID or by group name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the group. This is the group's position in the configuration and may change when other groups are removed; the provider locates the group by `name` and records the new ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Group name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Group description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "`local` for a group of local users, `remote` for a group matched by name against an external authentication server",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("local"),
+				Validators:          []validator.String{stringvalidator.OneOf("local", "remote")},
+			},
+			"members": schema.ListAttribute{
+				MarkdownDescription: "Names of the member users. Leave unset to manage membership with the `groups` attribute of `pfsense-v2_user` instead; setting both for the same user makes them fight.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"privileges": schema.ListAttribute{
+				MarkdownDescription: "Privileges granted to members, e.g. `page-all`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.CreateUserGroup(data.toDomain([]string{}))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group, got error: %s", err))
+		return
+	}
+	data.fromDomain(group)
+
+	tflog.Trace(ctx, "created a group", map[string]interface{}{"id": group.ID, "name": group.Name})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, drift, err := r.client.ResolveUserGroup(int(data.ID.ValueInt64()), data.Name.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(group)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GroupResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "group", func() (*pfsense_rest_v2.PFSenseUserGroup, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveUserGroup(int(state.ID.ValueInt64()), state.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	group, err := r.client.UpdateUserGroup(data.toDomain(current.Members))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update group, got error: %s", err))
+		return
+	}
+	data.fromDomain(group)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "group", func() (*pfsense_rest_v2.PFSenseUserGroup, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveUserGroup(int(data.ID.ValueInt64()), data.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteUserGroup(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group, got error: %s", err))
+	}
+}
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Group names must start with a letter, so anything that is not a number is a name.
+	_, value := importKey(req.ID, "name")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		groups, err := r.client.GetUserGroups()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read groups, got error: %s", err))
+			return
+		}
+		group, err := findUnique(groups, func(group *pfsense_rest_v2.PFSenseUserGroup) bool {
+			return group.Name == value
+		}, fmt.Sprintf("name %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Group", err.Error())
+			return
+		}
+		id = group.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// addIDDriftWarning reports that an object was found at a different pfSense ID
+// than the one recorded in state. The object was located by a stable attribute,
+// so the operation went ahead against the right object.
+func addIDDriftWarning(diags *diag.Diagnostics, drift *pfsense_rest_v2.IDDrift) {
+	if drift == nil {
+		return
+	}
+	diags.AddWarning(
+		"pfSense ID Changed",
+		fmt.Sprintf("The %s. This usually means an object before it was removed outside of Terraform. "+
+			"The new ID has been recorded in state.", drift),
+	)
+}
+
+// resolveForUpdate locates the object an update is about to change with
+// resolve, one of the client's Resolve* methods, so a renumbered ID cannot
+// redirect the update to an unrelated object. It reports any ID drift, and
+// adds an error and returns false when the object cannot be found.
+func resolveForUpdate[T any](diags *diag.Diagnostics, kind string, resolve func() (T, *pfsense_rest_v2.IDDrift, error)) (T, bool) {
+	item, drift, err := resolve()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", kind, err))
+		return item, false
+	}
+	addIDDriftWarning(diags, drift)
+	return item, true
+}
+
+// resolveForDelete locates the object a delete is about to remove like
+// resolveForUpdate, but returns false without an error when the object is
+// already gone.
+func resolveForDelete[T any](diags *diag.Diagnostics, kind string, resolve func() (T, *pfsense_rest_v2.IDDrift, error)) (T, bool) {
+	item, drift, err := resolve()
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		return item, false
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", kind, err))
+		return item, false
+	}
+	addIDDriftWarning(diags, drift)
+	return item, true
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// importKey splits an import identifier of the form `<key>:<value>` when the
+// prefix is one of keys. Any other identifier is returned unchanged with an
+// empty key, meaning it should be treated as a pfSense ID.
+func importKey(id string, keys ...string) (string, string) {
+	prefix, value, found := strings.Cut(id, ":")
+	if !found {
+		return "", id
+	}
+	for _, key := range keys {
+		if prefix == key {
+			return key, value
+		}
+	}
+	return "", id
+}
+
+// findUnique returns the single item for which match returns true. Natural
+// keys such as descriptions are not guaranteed unique by pfSense, so an
+// ambiguous match is reported rather than silently picking one.
+func findUnique[T any](items []T, match func(T) bool, description string) (T, error) {
+	var found []T
+	for _, item := range items {
+		if match(item) {
+			found = append(found, item)
+		}
+	}
+	var zero T
+	switch len(found) {
+	case 0:
+		return zero, fmt.Errorf("no object found with %s", description)
+	case 1:
+		return found[0], nil
+	default:
+		return zero, fmt.Errorf("%d objects found with %s; import by ID instead", len(found), description)
+	}
+}
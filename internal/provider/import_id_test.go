@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestImportKey(t *testing.T) {
+	cases := []struct {
+		id, key, value string
+	}{
+		{"12", "", "12"},
+		{"tracker:1700000000", "tracker", "1700000000"},
+		{"description:Allow: DNS", "description", "Allow: DNS"},
+		{"other:value", "", "other:value"},
+	}
+	for _, c := range cases {
+		key, value := importKey(c.id, "tracker", "description")
+		if key != c.key || value != c.value {
+			t.Errorf("importKey(%q) = (%q, %q), want (%q, %q)", c.id, key, value, c.key, c.value)
+		}
+	}
+}
+
+func TestFindUnique(t *testing.T) {
+	items := []string{"a", "b", "b"}
+
+	if got, err := findUnique(items, func(s string) bool { return s == "a" }, "a"); err != nil || got != "a" {
+		t.Errorf("expected unique match, got %q, %v", got, err)
+	}
+	if _, err := findUnique(items, func(s string) bool { return s == "b" }, "b"); err == nil {
+		t.Error("expected error for ambiguous match")
+	}
+	if _, err := findUnique(items, func(s string) bool { return s == "c" }, "c"); err == nil {
+		t.Error("expected error for missing match")
+	}
+}
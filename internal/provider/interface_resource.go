@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InterfaceResource{}
+var _ resource.ResourceWithImportState = &InterfaceResource{}
+
+func NewInterfaceResource() resource.Resource {
+	return &InterfaceResource{}
+}
+
+// InterfaceResource defines the resource implementation.
+type InterfaceResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// InterfaceResourceModel describes the resource data model.
+type InterfaceResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Port         types.String `tfsdk:"port"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Description  types.String `tfsdk:"description"`
+	SpoofMAC     types.String `tfsdk:"spoof_mac"`
+	MTU          types.Int64  `tfsdk:"mtu"`
+	BlockPrivate types.Bool   `tfsdk:"block_private"`
+	BlockBogons  types.Bool   `tfsdk:"block_bogons"`
+	IPv4Type     types.String `tfsdk:"ipv4_type"`
+	IPv4Address  types.String `tfsdk:"ipv4_address"`
+	IPv4Subnet   types.Int64  `tfsdk:"ipv4_subnet"`
+	IPv4Gateway  types.String `tfsdk:"ipv4_gateway"`
+	IPv6Type     types.String `tfsdk:"ipv6_type"`
+	IPv6Address  types.String `tfsdk:"ipv6_address"`
+	IPv6Subnet   types.Int64  `tfsdk:"ipv6_subnet"`
+	IPv6Gateway  types.String `tfsdk:"ipv6_gateway"`
+}
+
+func (m *InterfaceResourceModel) toDomain() *pfsense_rest_v2.PFSenseInterface {
+	return &pfsense_rest_v2.PFSenseInterface{
+		ID:           m.ID.ValueString(),
+		Port:         m.Port.ValueString(),
+		Enabled:      m.Enabled.ValueBool(),
+		Description:  m.Description.ValueString(),
+		SpoofMAC:     m.SpoofMAC.ValueStringPointer(),
+		MTU:          intPointer(m.MTU),
+		BlockPrivate: m.BlockPrivate.ValueBool(),
+		BlockBogons:  m.BlockBogons.ValueBool(),
+		IPv4Type:     m.IPv4Type.ValueString(),
+		IPv4Address:  m.IPv4Address.ValueStringPointer(),
+		IPv4Subnet:   intPointer(m.IPv4Subnet),
+		IPv4Gateway:  m.IPv4Gateway.ValueStringPointer(),
+		IPv6Type:     m.IPv6Type.ValueString(),
+		IPv6Address:  m.IPv6Address.ValueStringPointer(),
+		IPv6Subnet:   intPointer(m.IPv6Subnet),
+		IPv6Gateway:  m.IPv6Gateway.ValueStringPointer(),
+	}
+}
+
+func (m *InterfaceResourceModel) fromDomain(iface *pfsense_rest_v2.PFSenseInterface) {
+	m.ID = types.StringValue(iface.ID)
+	m.Port = types.StringValue(iface.Port)
+	m.Enabled = types.BoolValue(iface.Enabled)
+	m.Description = types.StringValue(iface.Description)
+	m.SpoofMAC = types.StringPointerValue(iface.SpoofMAC)
+	m.MTU = int64PointerValue(iface.MTU)
+	m.BlockPrivate = types.BoolValue(iface.BlockPrivate)
+	m.BlockBogons = types.BoolValue(iface.BlockBogons)
+	m.IPv4Type = types.StringValue(iface.IPv4Type)
+	m.IPv4Address = types.StringPointerValue(iface.IPv4Address)
+	m.IPv4Subnet = int64PointerValue(iface.IPv4Subnet)
+	m.IPv4Gateway = types.StringPointerValue(iface.IPv4Gateway)
+	m.IPv6Type = types.StringValue(iface.IPv6Type)
+	m.IPv6Address = types.StringPointerValue(iface.IPv6Address)
+	m.IPv6Subnet = int64PointerValue(iface.IPv6Subnet)
+	m.IPv6Gateway = types.StringPointerValue(iface.IPv6Gateway)
+}
+
+func (r *InterfaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface"
+}
+
+func (r *InterfaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Interface assignment and addressing. Can be imported by interface ID (e.g. `opt1`), " +
+			"by `port:<port>` or by `description:<description>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "pfSense interface ID (e.g. `wan`, `lan`, `opt1`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "Physical or virtual device to assign (e.g. `igb1`, `igb1.100`)",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the interface is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Interface description, shown as its name in the webGUI",
+				Required:            true,
+			},
+			"spoof_mac": schema.StringAttribute{
+				MarkdownDescription: "MAC address to use instead of the device's own",
+				Optional:            true,
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "MTU. Leave unset to use the device default.",
+				Optional:            true,
+			},
+			"block_private": schema.BoolAttribute{
+				MarkdownDescription: "Block traffic from private networks and loopback addresses",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"block_bogons": schema.BoolAttribute{
+				MarkdownDescription: "Block traffic from reserved and unassigned networks",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ipv4_type": schema.StringAttribute{
+				MarkdownDescription: "IPv4 configuration type",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(pfsense_rest_v2.NetworkInterfaceTypev4None)),
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(pfsense_rest_v2.NetworkInterfaceTypev4Static),
+					string(pfsense_rest_v2.NetworkInterfaceTypev4Dhcp),
+					string(pfsense_rest_v2.NetworkInterfaceTypev4None),
+				)},
+			},
+			"ipv4_address": schema.StringAttribute{
+				MarkdownDescription: "Static IPv4 address. Only used when `ipv4_type` is `static`.",
+				Optional:            true,
+			},
+			"ipv4_subnet": schema.Int64Attribute{
+				MarkdownDescription: "IPv4 subnet prefix length. Only used when `ipv4_type` is `static`.",
+				Optional:            true,
+			},
+			"ipv4_gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the upstream IPv4 gateway. Only used when `ipv4_type` is `static`.",
+				Optional:            true,
+			},
+			"ipv6_type": schema.StringAttribute{
+				MarkdownDescription: "IPv6 configuration type",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(pfsense_rest_v2.NetworkInterfaceTypev6None)),
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(pfsense_rest_v2.NetworkInterfaceTypev6Staticv6),
+					string(pfsense_rest_v2.NetworkInterfaceTypev6Dhcp6),
+					string(pfsense_rest_v2.NetworkInterfaceTypev6Slaac),
+					string(pfsense_rest_v2.NetworkInterfaceTypev6Track6),
+					string(pfsense_rest_v2.NetworkInterfaceTypev6None),
+				)},
+			},
+			"ipv6_address": schema.StringAttribute{
+				MarkdownDescription: "Static IPv6 address. Only used when `ipv6_type` is `staticv6`.",
+				Optional:            true,
+			},
+			"ipv6_subnet": schema.Int64Attribute{
+				MarkdownDescription: "IPv6 prefix length. Only used when `ipv6_type` is `staticv6`.",
+				Optional:            true,
+			},
+			"ipv6_gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the upstream IPv6 gateway. Only used when `ipv6_type` is `staticv6`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *InterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InterfaceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface, err := r.client.CreateInterface(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create interface, got error: %s", err))
+		return
+	}
+	data.fromDomain(iface)
+
+	tflog.Trace(ctx, "created an interface", map[string]interface{}{"id": iface.ID})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the interface
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyInterfaces(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply interface changes, got error: %s", err))
+	}
+}
+
+func (r *InterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InterfaceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface, err := r.client.GetInterface(data.ID.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read interface, got error: %s", err))
+		return
+	}
+	data.fromDomain(iface)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InterfaceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface, err := r.client.UpdateInterface(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update interface, got error: %s", err))
+		return
+	}
+	data.fromDomain(iface)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyInterfaces(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply interface changes, got error: %s", err))
+	}
+}
+
+func (r *InterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InterfaceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteInterface(data.ID.ValueString())
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete interface, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyInterfaces(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply interface changes, got error: %s", err))
+	}
+}
+
+func (r *InterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	key, value := importKey(req.ID, "port", "description")
+
+	// Interface IDs are names rather than positions, so they can be used directly.
+	id := value
+	if key != "" {
+		ifaces, err := r.client.GetInterfaces()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read interfaces, got error: %s", err))
+			return
+		}
+		iface, err := findUnique(ifaces, func(iface *pfsense_rest_v2.PFSenseInterface) bool {
+			if key == "port" {
+				return iface.Port == value
+			}
+			return iface.Description == value
+		}, fmt.Sprintf("%s %q", key, value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Interface", err.Error())
+			return
+		}
+		id = iface.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringValues converts a slice of strings into framework string values. The
+// result is never nil so an empty slice round-trips as an empty list, not null.
+func stringValues(values []string) []types.String {
+	result := []types.String{}
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
+
+// stringsFromValues converts framework string values into a slice of strings,
+// skipping null and unknown entries.
+func stringsFromValues(values []types.String) []string {
+	result := []string{}
+	for _, v := range values {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		result = append(result, v.ValueString())
+	}
+	return result
+}
+
+// int64Values converts a slice of ints into framework int64 values. Like
+// stringValues, the result is never nil.
+func int64Values(values []int) []types.Int64 {
+	result := []types.Int64{}
+	for _, v := range values {
+		result = append(result, types.Int64Value(int64(v)))
+	}
+	return result
+}
+
+// intsFromValues converts framework int64 values into a slice of ints,
+// skipping null and unknown entries.
+func intsFromValues(values []types.Int64) []int {
+	result := []int{}
+	for _, v := range values {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		result = append(result, int(v.ValueInt64()))
+	}
+	return result
+}
+
+// int64PointerValue converts an optional int into a framework int64 value.
+func int64PointerValue(p *int) types.Int64 {
+	if p == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*p))
+}
+
+// intPointer converts a framework int64 value into an optional int.
+func intPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// stringOr returns the value, or fallback when it is null or unknown.
+func stringOr(value types.String, fallback string) string {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+	return value.ValueString()
+}
+
+// int64Or returns the value as an int, or fallback when it is null or unknown.
+func int64Or(value types.Int64, fallback int) int {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+	return int(value.ValueInt64())
+}
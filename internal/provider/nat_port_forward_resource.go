@@ -0,0 +1,429 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NATPortForwardResource{}
+var _ resource.ResourceWithImportState = &NATPortForwardResource{}
+var _ resource.ResourceWithModifyPlan = &NATPortForwardResource{}
+var _ resource.ResourceWithValidateConfig = &NATPortForwardResource{}
+
+func NewNATPortForwardResource() resource.Resource {
+	return &NATPortForwardResource{}
+}
+
+// NATPortForwardResource defines the resource implementation.
+type NATPortForwardResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// NATPortForwardResourceModel describes the resource data model.
+type NATPortForwardResourceModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Interface        types.String `tfsdk:"interface"`
+	AddressFamily    types.String `tfsdk:"address_family"`
+	Protocol         types.String `tfsdk:"protocol"`
+	Source           types.String `tfsdk:"source"`
+	SourcePort       types.String `tfsdk:"source_port"`
+	Destination      types.String `tfsdk:"destination"`
+	DestinationPort  types.String `tfsdk:"destination_port"`
+	Target           types.String `tfsdk:"target"`
+	LocalPort        types.String `tfsdk:"local_port"`
+	Disabled         types.Bool   `tfsdk:"disabled"`
+	NoRDR            types.Bool   `tfsdk:"no_rdr"`
+	NoSync           types.Bool   `tfsdk:"no_sync"`
+	Description      types.String `tfsdk:"description"`
+	NATReflection    types.String `tfsdk:"nat_reflection"`
+	AssociatedRuleID types.String `tfsdk:"associated_rule_id"`
+}
+
+func (m *NATPortForwardResourceModel) toDomain() *pfsense_rest_v2.PFSenseNATPortForward {
+	return &pfsense_rest_v2.PFSenseNATPortForward{
+		ID:              int(m.ID.ValueInt64()),
+		Interface:       m.Interface.ValueString(),
+		AddressFamily:   m.AddressFamily.ValueString(),
+		Protocol:        m.Protocol.ValueString(),
+		Source:          m.Source.ValueString(),
+		SourcePort:      m.SourcePort.ValueStringPointer(),
+		Destination:     m.Destination.ValueString(),
+		DestinationPort: m.DestinationPort.ValueStringPointer(),
+		Target:          m.Target.ValueString(),
+		LocalPort:       m.LocalPort.ValueStringPointer(),
+		Disabled:        m.Disabled.ValueBool(),
+		NoRDR:           m.NoRDR.ValueBool(),
+		NoSync:          m.NoSync.ValueBool(),
+		Description:     m.Description.ValueString(),
+		NATReflection:   m.NATReflection.ValueStringPointer(),
+	}
+}
+
+func (m *NATPortForwardResourceModel) fromDomain(pf *pfsense_rest_v2.PFSenseNATPortForward) {
+	m.ID = types.Int64Value(int64(pf.ID))
+	m.Interface = types.StringValue(pf.Interface)
+	m.AddressFamily = types.StringValue(pf.AddressFamily)
+	m.Protocol = types.StringValue(pf.Protocol)
+	m.Source = types.StringValue(pf.Source)
+	m.SourcePort = types.StringPointerValue(pf.SourcePort)
+	m.Destination = types.StringValue(pf.Destination)
+	m.DestinationPort = types.StringPointerValue(pf.DestinationPort)
+	m.Target = types.StringValue(pf.Target)
+	m.LocalPort = types.StringPointerValue(pf.LocalPort)
+	m.Disabled = types.BoolValue(pf.Disabled)
+	m.NoRDR = types.BoolValue(pf.NoRDR)
+	m.NoSync = types.BoolValue(pf.NoSync)
+	m.Description = types.StringValue(pf.Description)
+	m.NATReflection = types.StringPointerValue(pf.NATReflection)
+	m.AssociatedRuleID = types.StringPointerValue(pf.AssociatedRuleID)
+}
+
+// stateKey returns the port forward as recorded in state, to locate it on the
+// device.
+func (m *NATPortForwardResourceModel) stateKey() *pfsense_rest_v2.PFSenseNATPortForward {
+	pf := m.toDomain()
+	pf.AssociatedRuleID = m.AssociatedRuleID.ValueStringPointer()
+	return pf
+}
+
+func (r *NATPortForwardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_nat_port_forward"
+}
+
+func (r *NATPortForwardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NAT port forward. Can be imported by pfSense ID or by `description:<description>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the port forward. This is the port forward's position in the configuration and may change when other port forwards are removed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface on which traffic is matched",
+				Required:            true,
+			},
+			"address_family": schema.StringAttribute{
+				MarkdownDescription: "Address family (IPv4/IPv6)",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(pfsense_rest_v2.PortForwardIpprotocolInet)),
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(pfsense_rest_v2.PortForwardIpprotocolInet),   // IPv4
+					string(pfsense_rest_v2.PortForwardIpprotocolInet6),  // IPv6
+					string(pfsense_rest_v2.PortForwardIpprotocolInet46), // IPv4 and IPv6
+				)},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol. Supported values: ah, esp, gre, icmp, igmp, ipv6, ospf, pim, tcp, tcp/udp, udp.",
+				Required:            true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(pfsense_rest_v2.PortForwardProtocolAh),
+					string(pfsense_rest_v2.PortForwardProtocolEsp),
+					string(pfsense_rest_v2.PortForwardProtocolGre),
+					string(pfsense_rest_v2.PortForwardProtocolIcmp),
+					string(pfsense_rest_v2.PortForwardProtocolIgmp),
+					string(pfsense_rest_v2.PortForwardProtocolIpv6),
+					string(pfsense_rest_v2.PortForwardProtocolOspf),
+					string(pfsense_rest_v2.PortForwardProtocolPim),
+					string(pfsense_rest_v2.PortForwardProtocolTcp),
+					string(pfsense_rest_v2.PortForwardProtocolTcpudp),
+					string(pfsense_rest_v2.PortForwardProtocolUdp),
+				)},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The source address this port forward applies to. Valid value options are: an existing interface, an IP address, a subnet CIDR, an existing alias, `any`, `(self)`, `l2tp`, `pppoe`. The context of this address can be inverted by prefixing the value with `!`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("any"),
+				Validators:          []validator.String{AddressExpressionValidator{}},
+			},
+			"source_port": schema.StringAttribute{
+				MarkdownDescription: "The source port this port forward applies to. Leave unset to allow any source port. Valid options are: a TCP/UDP port number, a TCP/UDP port range separated by `:`, an existing port type firewall alias.",
+				Optional:            true,
+				Validators:          []validator.String{PortRangeOrNullValidator{}},
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "The destination address this port forward applies to. Valid value options are: an existing interface, an IP address, a subnet CIDR, an existing alias, `any`, `(self)`, `l2tp`, `pppoe`. The context of this address can be inverted by prefixing the value with `!`. For interface values, the `:ip` modifier can be appended to the value to use the interface's IP address instead of its entire subnet.",
+				Required:            true,
+				Validators:          []validator.String{AddressExpressionValidator{}},
+			},
+			"destination_port": schema.StringAttribute{
+				MarkdownDescription: "The destination port this port forward applies to. Leave unset to allow any destination port. Valid options are: a TCP/UDP port number, a TCP/UDP port range separated by `:`, an existing port type firewall alias.",
+				Optional:            true,
+				Validators:          []validator.String{PortRangeOrNullValidator{}},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The IP address or alias of the internal host to redirect traffic to",
+				Required:            true,
+			},
+			"local_port": schema.StringAttribute{
+				MarkdownDescription: "The port on the internal host to redirect traffic to. For port ranges, this is the first port of the range.",
+				Optional:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the port forward is disabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"no_rdr": schema.BoolAttribute{
+				MarkdownDescription: "Disable redirection for traffic matching this rule",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"no_sync": schema.BoolAttribute{
+				MarkdownDescription: "Prevent this rule from being synchronized to other HA peers",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Port forward description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"nat_reflection": schema.StringAttribute{
+				MarkdownDescription: "NAT reflection mode. Leave unset to use the system default.",
+				Optional:            true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(pfsense_rest_v2.PortForwardNatreflectionEnable),
+					string(pfsense_rest_v2.PortForwardNatreflectionDisable),
+					string(pfsense_rest_v2.PortForwardNatreflectionPurenat),
+				)},
+			},
+			"associated_rule_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the filter rule pfSense created for the port forward, if any. Used to find the port forward again when its pfSense ID changes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NATPortForwardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config := configStrings(ctx, req.Config, &resp.Diagnostics, "protocol", "address_family", "source_port", "destination_port", "local_port", "source", "destination", "target")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validatePortsForProtocol(&resp.Diagnostics, config["protocol"], map[string]types.String{
+		"source_port":      config["source_port"],
+		"destination_port": config["destination_port"],
+		"local_port":       config["local_port"],
+	})
+	validateAddressFamily(&resp.Diagnostics, config["address_family"], map[string]types.String{
+		"source":      config["source"],
+		"destination": config["destination"],
+		"target":      config["target"],
+	})
+}
+
+func (r *NATPortForwardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the port forward is being destroyed or checks are disabled
+	if req.Plan.Raw.IsNull() || r.client == nil || !r.client.ValidateReferences {
+		return
+	}
+
+	checkReferences(ctx, r.client, req.Plan, referenceAttributes{
+		Addresses:  []string{"source", "destination", "target"},
+		Ports:      []string{"source_port", "destination_port", "local_port"},
+		Interfaces: []string{"interface"},
+	}, &resp.Diagnostics)
+}
+
+func (r *NATPortForwardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NATPortForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NATPortForwardResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pf, err := r.client.CreateNATPortForward(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NAT port forward, got error: %s", err))
+		return
+	}
+	data.fromDomain(pf)
+
+	tflog.Trace(ctx, "created a NAT port forward", map[string]interface{}{"id": pf.ID})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the port forward
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *NATPortForwardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NATPortForwardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pf, drift, err := r.client.ResolveNATPortForward(int(data.ID.ValueInt64()), data.stateKey())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NAT port forward, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(pf)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NATPortForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NATPortForwardResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "NAT port forward", func() (*pfsense_rest_v2.PFSenseNATPortForward, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveNATPortForward(int(state.ID.ValueInt64()), state.stateKey())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	pf, err := r.client.UpdateNATPortForward(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update NAT port forward, got error: %s", err))
+		return
+	}
+	data.fromDomain(pf)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *NATPortForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NATPortForwardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "NAT port forward", func() (*pfsense_rest_v2.PFSenseNATPortForward, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveNATPortForward(int(data.ID.ValueInt64()), data.stateKey())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteNATPortForward(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NAT port forward, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *NATPortForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	key, value := importKey(req.ID, "description")
+
+	var id int
+	switch key {
+	case "description":
+		pfs, err := r.client.GetNATPortForwards()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NAT port forwards, got error: %s", err))
+			return
+		}
+		pf, err := findUnique(pfs, func(pf *pfsense_rest_v2.PFSenseNATPortForward) bool {
+			return pf.Description == value
+		}, fmt.Sprintf("description %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import NAT Port Forward", err.Error())
+			return
+		}
+		id = pf.ID
+	default:
+		var err error
+		id, err = strconv.Atoi(value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected a numeric pfSense ID or `description:<description>`, got: %q", req.ID),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PackageResource{}
+var _ resource.ResourceWithImportState = &PackageResource{}
+
+// packageNamePattern matches the name of a pfSense package.
+var packageNamePattern = regexp.MustCompile(`^pfSense-pkg-[A-Za-z0-9._+-]+$`)
+
+func NewPackageResource() resource.Resource {
+	return &PackageResource{}
+}
+
+// PackageResource defines the resource implementation.
+type PackageResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// PackageResourceModel describes the resource data model.
+type PackageResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	InstalledVersion types.String `tfsdk:"installed_version"`
+	LatestVersion    types.String `tfsdk:"latest_version"`
+	UpdateAvailable  types.Bool   `tfsdk:"update_available"`
+}
+
+func (m *PackageResourceModel) fromDomain(item *pfsense_rest_v2.PFSenseInstalledPackage) {
+	m.ID = types.StringValue(item.Name)
+	m.Name = types.StringValue(item.Name)
+	m.InstalledVersion = types.StringValue(item.InstalledVersion)
+	m.LatestVersion = types.StringValue(item.LatestVersion)
+	m.UpdateAvailable = types.BoolValue(item.UpdateAvailable)
+}
+
+func (r *PackageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_package"
+}
+
+func (r *PackageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Installed package. Installing can take several minutes; the provider waits for it to finish. " +
+			"Upgrades are not performed: `update_available` reports when one is due. Can be imported by package name.\n\n" +
+			"No resource of this provider manages a package's own configuration (e.g. HAProxy or ACME) yet, so none depends on a package " +
+			"and there is nothing to check for a missing one. Resources added for package endpoints must check that their package is installed " +
+			"and fail with a diagnostic naming it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Package name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Package name, e.g. `pfSense-pkg-haproxy`. See the `pfsense-v2_packages` data source for the available packages.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(packageNamePattern, "must be a pfSense package name starting with pfSense-pkg-"),
+				},
+			},
+			"installed_version": schema.StringAttribute{
+				MarkdownDescription: "Installed version",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"latest_version": schema.StringAttribute{
+				MarkdownDescription: "Newest version in the package repository",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether the repository has a newer version than the installed one",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PackageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PackageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PackageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	_, err := r.client.GetInstalledPackage(name)
+	if err == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Package Already Installed",
+			fmt.Sprintf("%s is already installed. Import it with `terraform import` to manage it.", name))
+		return
+	}
+	if !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read installed packages, got error: %s", err))
+		return
+	}
+
+	item, err := r.client.InstallPackage(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to install package, got error: %s", err))
+		return
+	}
+	data.fromDomain(item)
+
+	tflog.Trace(ctx, "installed a package", map[string]interface{}{"name": item.Name, "version": item.InstalledVersion})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PackageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := r.client.GetInstalledPackage(data.ID.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read package, got error: %s", err))
+		return
+	}
+	data.fromDomain(item)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with a change to the package, since changing the
+// name replaces the resource.
+func (r *PackageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PackageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PackageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Package IDs move with every install, so look the package up by name
+	item, err := r.client.GetInstalledPackage(data.ID.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read package, got error: %s", err))
+		return
+	}
+
+	err = r.client.DeletePackage(item)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove package, got error: %s", err))
+	}
+}
+
+func (r *PackageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccPackageResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "pfsense-v2_package" "test" {
+  name = "pfSense-pkg-Cron"
+}
+
+data "pfsense-v2_packages" "test" {
+  include_available = false
+
+  depends_on = [pfsense-v2_package.test]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_package.test",
+						tfjsonpath.New("installed_version"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.pfsense-v2_packages.test",
+						tfjsonpath.New("available"),
+						knownvalue.ListSizeExact(0),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pfsense-v2_package.test",
+				ImportState:       true,
+				ImportStateId:     "pfSense-pkg-Cron",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PackagesDataSource{}
+
+func NewPackagesDataSource() datasource.DataSource {
+	return &PackagesDataSource{}
+}
+
+// PackagesDataSource defines the data source implementation.
+type PackagesDataSource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// PackagesDataSourceModel describes the data source data model.
+type PackagesDataSourceModel struct {
+	IncludeAvailable types.Bool              `tfsdk:"include_available"`
+	Installed        []InstalledPackageModel `tfsdk:"installed"`
+	Available        []AvailablePackageModel `tfsdk:"available"`
+}
+
+// InstalledPackageModel describes one entry of installed.
+type InstalledPackageModel struct {
+	Name             types.String `tfsdk:"name"`
+	ShortName        types.String `tfsdk:"short_name"`
+	Description      types.String `tfsdk:"description"`
+	InstalledVersion types.String `tfsdk:"installed_version"`
+	LatestVersion    types.String `tfsdk:"latest_version"`
+	UpdateAvailable  types.Bool   `tfsdk:"update_available"`
+}
+
+// AvailablePackageModel describes one entry of available.
+type AvailablePackageModel struct {
+	Name         types.String   `tfsdk:"name"`
+	ShortName    types.String   `tfsdk:"short_name"`
+	Description  types.String   `tfsdk:"description"`
+	Version      types.String   `tfsdk:"version"`
+	Installed    types.Bool     `tfsdk:"installed"`
+	Dependencies []types.String `tfsdk:"dependencies"`
+}
+
+func (d *PackagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_packages"
+}
+
+func (d *PackagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// packageAttributes returns the attributes both lists share.
+	packageAttributes := func() map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Package name, e.g. `pfSense-pkg-haproxy`",
+				Computed:            true,
+			},
+			"short_name": schema.StringAttribute{
+				MarkdownDescription: "Name shown in the package manager, e.g. `haproxy`",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Package description",
+				Computed:            true,
+			},
+		}
+	}
+
+	installed := packageAttributes()
+	installed["installed_version"] = schema.StringAttribute{
+		MarkdownDescription: "Installed version",
+		Computed:            true,
+	}
+	installed["latest_version"] = schema.StringAttribute{
+		MarkdownDescription: "Newest version in the package repository",
+		Computed:            true,
+	}
+	installed["update_available"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the repository has a newer version",
+		Computed:            true,
+	}
+
+	available := packageAttributes()
+	available["version"] = schema.StringAttribute{
+		MarkdownDescription: "Version in the package repository",
+		Computed:            true,
+	}
+	available["installed"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the package is installed",
+		Computed:            true,
+	}
+	available["dependencies"] = schema.ListAttribute{
+		MarkdownDescription: "Packages installed along with it",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Packages installed on the device and packages available from its package repository.",
+
+		Attributes: map[string]schema.Attribute{
+			"include_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether to list the available packages, which the device fetches from the package repository. Defaults to `true`; set `false` for devices without internet access.",
+				Optional:            true,
+			},
+			"installed": schema.ListNestedAttribute{
+				MarkdownDescription: "Installed packages, by name",
+				Computed:            true,
+				NestedObject:        schema.NestedAttributeObject{Attributes: installed},
+			},
+			"available": schema.ListNestedAttribute{
+				MarkdownDescription: "Available packages, by name. Empty when `include_available` is `false`.",
+				Computed:            true,
+				NestedObject:        schema.NestedAttributeObject{Attributes: available},
+			},
+		},
+	}
+}
+
+func (d *PackagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PackagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PackagesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	installed, err := d.client.GetInstalledPackages()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read installed packages, got error: %s", err))
+		return
+	}
+	slices.SortFunc(installed, func(a, b *pfsense_rest_v2.PFSenseInstalledPackage) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	data.Installed = []InstalledPackageModel{}
+	for _, p := range installed {
+		data.Installed = append(data.Installed, InstalledPackageModel{
+			Name:             types.StringValue(p.Name),
+			ShortName:        types.StringValue(p.ShortName),
+			Description:      types.StringValue(p.Description),
+			InstalledVersion: types.StringValue(p.InstalledVersion),
+			LatestVersion:    types.StringValue(p.LatestVersion),
+			UpdateAvailable:  types.BoolValue(p.UpdateAvailable),
+		})
+	}
+
+	data.Available = []AvailablePackageModel{}
+	if data.IncludeAvailable.ValueBool() || data.IncludeAvailable.IsNull() {
+		available, err := d.client.GetAvailablePackages()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read available packages, got error: %s", err))
+			return
+		}
+		slices.SortFunc(available, func(a, b *pfsense_rest_v2.PFSenseAvailablePackage) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		for _, p := range available {
+			data.Available = append(data.Available, AvailablePackageModel{
+				Name:         types.StringValue(p.Name),
+				ShortName:    types.StringValue(p.ShortName),
+				Description:  types.StringValue(p.Description),
+				Version:      types.StringValue(p.Version),
+				Installed:    types.BoolValue(p.Installed),
+				Dependencies: stringValues(p.Dependencies),
+			})
+		}
+	}
+
+	tflog.Trace(ctx, "read packages", map[string]interface{}{"installed": len(data.Installed), "available": len(data.Available)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = ParseAddressFunction{}
+)
+
+var addressExpressionAttributeTypes = map[string]attr.Type{
+	"kind":         types.StringType,
+	"value":        types.StringType,
+	"inverted":     types.BoolType,
+	"interface_ip": types.BoolType,
+}
+
+func NewParseAddressFunction() function.Function {
+	return ParseAddressFunction{}
+}
+
+// ParseAddressFunction parses a rule source or destination into its parts.
+type ParseAddressFunction struct{}
+
+func (r ParseAddressFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_address"
+}
+
+func (r ParseAddressFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse an address expression",
+		MarkdownDescription: "Parses an address expression as accepted by the `source` and `destination` attributes of rules and port forwards. `kind` is one of `any`, `self`, `l2tp`, `pppoe`, `address`, `network`, `interface` or `alias`; `value` is the address, network or name without the `!` prefix and `:ip` modifier, which are reported by `inverted` and `interface_ip`. Bare names are reported as `interface` when they follow pfSense's interface naming (`wan`, `lan`, `optN`) and as `alias` otherwise.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "Address expression to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: addressExpressionAttributeTypes,
+		},
+	}
+}
+
+func (r ParseAddressFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var data string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &data))
+
+	if resp.Error != nil {
+		return
+	}
+
+	expr, err := ParseAddressExpression(data)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := types.ObjectValueMust(addressExpressionAttributeTypes, map[string]attr.Value{
+		"kind":         types.StringValue(expr.Kind),
+		"value":        types.StringValue(expr.Value),
+		"inverted":     types.BoolValue(expr.Inverted),
+		"interface_ip": types.BoolValue(expr.InterfaceIP),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
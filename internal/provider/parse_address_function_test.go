@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseAddressFunction_Known(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::pfsense-v2::parse_address("!lan:ip")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"kind":         knownvalue.StringExact("interface"),
+							"value":        knownvalue.StringExact("lan"),
+							"inverted":     knownvalue.Bool(true),
+							"interface_ip": knownvalue.Bool(true),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestParseAddressFunction_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::pfsense-v2::parse_address("lan:ipp")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown address modifier`),
+			},
+		},
+	})
+}
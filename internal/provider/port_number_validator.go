@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// PortNumberValidator validates that a string is a TCP port number in the
+// range 1-65535, written without a sign or leading zeros.
+type PortNumberValidator struct{}
+
+func (v PortNumberValidator) Description(ctx context.Context) string {
+	return "value must be a port number between 1 and 65535"
+}
+
+func (v PortNumberValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v PortNumberValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !isPortNumber(value) {
+		resp.Diagnostics.Append(
+			diag.NewAttributeErrorDiagnostic(
+				req.Path,
+				"Invalid port value",
+				"Value must be a number between 1 and 65535, got: "+value,
+			),
+		)
+	}
+}
+
+// isPortNumber reports whether value is a port number as pfSense writes one.
+func isPortNumber(value string) bool {
+	n, err := strconv.Atoi(value)
+	return err == nil && n >= 1 && n <= 65535 && strconv.Itoa(n) == value
+}
@@ -0,0 +1,18 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestIsPortNumber(t *testing.T) {
+	for _, value := range []string{"1", "22", "443", "65535"} {
+		if !isPortNumber(value) {
+			t.Errorf("isPortNumber(%q) = false, want true", value)
+		}
+	}
+	for _, value := range []string{"", "0", "65536", "99999", "080", "+80", "-1", "ssh"} {
+		if isPortNumber(value) {
+			t.Errorf("isPortNumber(%q) = true, want false", value)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PortRange is a parsed pfSense port expression. Any is set when the
+// expression matches every port, in which case From and To are zero.
+type PortRange struct {
+	From int
+	To   int
+	Any  bool
+}
+
+// ParsePortRange parses pfSense's port grammar: `null` for any port, a single
+// port number, or a range of two port numbers separated by `:`.
+func ParsePortRange(val string) (PortRange, error) {
+	if val == "null" {
+		return PortRange{Any: true}, nil
+	}
+
+	if fromStr, toStr, isRange := strings.Cut(val, ":"); isRange {
+		from, err := PortNumber(fromStr)
+		if err != nil {
+			return PortRange{}, fmt.Errorf("invalid port number in range: %s", fromStr)
+		}
+		to, err := PortNumber(toStr)
+		if err != nil {
+			return PortRange{}, fmt.Errorf("invalid port number in range: %s", toStr)
+		}
+		if from > to {
+			return PortRange{}, fmt.Errorf("port range %s starts after it ends", val)
+		}
+		return PortRange{From: from, To: to}, nil
+	}
+
+	port, err := PortNumber(val)
+	if err != nil {
+		return PortRange{}, fmt.Errorf("invalid port value: %s", val)
+	}
+	return PortRange{From: port, To: port}, nil
+}
+
+// String formats the range back into pfSense's port grammar.
+func (r PortRange) String() string {
+	switch {
+	case r.Any:
+		return "null"
+	case r.From == r.To:
+		return fmt.Sprint(r.From)
+	default:
+		return fmt.Sprintf("%d:%d", r.From, r.To)
+	}
+}
+
+// isPortAlias reports whether a port value names a port alias rather than
+// giving ports directly. Alias names cannot be purely numeric.
+func isPortAlias(val string) bool {
+	if val == "null" || !addressNamePattern.MatchString(val) {
+		return false
+	}
+	return strings.Trim(val, "0123456789") != ""
+}
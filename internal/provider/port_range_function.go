@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = PortRangeFunction{}
+	_ function.Function = FormatPortRangeFunction{}
+)
+
+var portRangeAttributeTypes = map[string]attr.Type{
+	"from": types.Int64Type,
+	"to":   types.Int64Type,
+	"any":  types.BoolType,
+}
+
+func NewPortRangeFunction() function.Function {
+	return PortRangeFunction{}
+}
+
+// PortRangeFunction parses a pfSense port expression into its bounds.
+type PortRangeFunction struct{}
+
+func (r PortRangeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "port_range"
+}
+
+func (r PortRangeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a port expression",
+		MarkdownDescription: "Parses a port expression as accepted by the `source_port` and `destination_port` attributes (`80`, `1000:2000` or `null`) into an object with `from`, `to` and `any`. A null argument or the string `null` matches any port, and returns `any = true` with null bounds.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "Port expression to parse",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: portRangeAttributeTypes,
+		},
+	}
+}
+
+func (r PortRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var data types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &data))
+
+	if resp.Error != nil {
+		return
+	}
+
+	portRange := PortRange{Any: true}
+	if !data.IsNull() {
+		var err error
+		portRange, err = ParsePortRange(data.ValueString())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+	}
+
+	from, to := types.Int64Null(), types.Int64Null()
+	if !portRange.Any {
+		from = types.Int64Value(int64(portRange.From))
+		to = types.Int64Value(int64(portRange.To))
+	}
+	result := types.ObjectValueMust(portRangeAttributeTypes, map[string]attr.Value{
+		"from": from,
+		"to":   to,
+		"any":  types.BoolValue(portRange.Any),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func NewFormatPortRangeFunction() function.Function {
+	return FormatPortRangeFunction{}
+}
+
+// FormatPortRangeFunction is the inverse of PortRangeFunction.
+type FormatPortRangeFunction struct{}
+
+func (r FormatPortRangeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_port_range"
+}
+
+func (r FormatPortRangeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Format a port expression",
+		MarkdownDescription: "Formats port bounds as a port expression for the `source_port` and `destination_port` attributes. Returns a single port when `to` is null or equal to `from`, `from:to` otherwise, and null when both bounds are null so the attribute matches any port.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "from",
+				MarkdownDescription: "First port of the range",
+				AllowNullValue:      true,
+			},
+			function.Int64Parameter{
+				Name:                "to",
+				MarkdownDescription: "Last port of the range",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (r FormatPortRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var from, to types.Int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &from, &to))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if from.IsNull() && to.IsNull() {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringNull()))
+		return
+	}
+	if from.IsNull() {
+		resp.Error = function.NewArgumentFuncError(0, "from must be set when to is set")
+		return
+	}
+	if to.IsNull() {
+		to = from
+	}
+
+	for i, bound := range []types.Int64{from, to} {
+		if bound.ValueInt64() < 1 || bound.ValueInt64() > math.MaxUint16 {
+			resp.Error = function.NewArgumentFuncError(int64(i), "port must be a number between 1 and 65535")
+			return
+		}
+	}
+	if from.ValueInt64() > to.ValueInt64() {
+		resp.Error = function.NewArgumentFuncError(1, "to must not be less than from")
+		return
+	}
+
+	portRange := PortRange{From: int(from.ValueInt64()), To: int(to.ValueInt64())}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringValue(portRange.String())))
+}
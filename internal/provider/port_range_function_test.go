@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func testPortRangeOutput(value string, expected knownvalue.Check) resource.TestStep {
+	return resource.TestStep{
+		Config: `
+		output "test" {
+			value = ` + value + `
+		}
+		`,
+		ConfigStateChecks: []statecheck.StateCheck{
+			statecheck.ExpectKnownOutputValue("test", expected),
+		},
+	}
+}
+
+func portRangeObject(from, to knownvalue.Check, any bool) knownvalue.Check {
+	return knownvalue.ObjectExact(map[string]knownvalue.Check{
+		"from": from,
+		"to":   to,
+		"any":  knownvalue.Bool(any),
+	})
+}
+
+func TestPortRangeFunction_Known(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			testPortRangeOutput(`provider::pfsense-v2::port_range("80")`,
+				portRangeObject(knownvalue.Int64Exact(80), knownvalue.Int64Exact(80), false)),
+			testPortRangeOutput(`provider::pfsense-v2::port_range("1000:2000")`,
+				portRangeObject(knownvalue.Int64Exact(1000), knownvalue.Int64Exact(2000), false)),
+			testPortRangeOutput(`provider::pfsense-v2::port_range("null")`,
+				portRangeObject(knownvalue.Null(), knownvalue.Null(), true)),
+			testPortRangeOutput(`provider::pfsense-v2::port_range(null)`,
+				portRangeObject(knownvalue.Null(), knownvalue.Null(), true)),
+		},
+	})
+}
+
+func TestPortRangeFunction_Invalid(t *testing.T) {
+	for _, value := range []string{"0", "65536", "http", "2000:1000", "80:"} {
+		resource.UnitTest(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					output "test" {
+						value = provider::pfsense-v2::port_range("` + value + `")
+					}
+					`,
+					ExpectError: regexp.MustCompile(`port`),
+				},
+			},
+		})
+	}
+}
+
+func TestFormatPortRangeFunction_Known(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			testPortRangeOutput(`provider::pfsense-v2::format_port_range(80, null)`,
+				knownvalue.StringExact("80")),
+			testPortRangeOutput(`provider::pfsense-v2::format_port_range(80, 80)`,
+				knownvalue.StringExact("80")),
+			testPortRangeOutput(`provider::pfsense-v2::format_port_range(1000, 2000)`,
+				knownvalue.StringExact("1000:2000")),
+			testPortRangeOutput(`provider::pfsense-v2::format_port_range(null, null)`,
+				knownvalue.Null()),
+		},
+	})
+}
+
+func TestFormatPortRangeFunction_RoundTrip(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			testPortRangeOutput(`provider::pfsense-v2::format_port_range(
+				provider::pfsense-v2::port_range("1000:2000").from,
+				provider::pfsense-v2::port_range("1000:2000").to,
+			)`, knownvalue.StringExact("1000:2000")),
+		},
+	})
+}
+
+func TestFormatPortRangeFunction_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::pfsense-v2::format_port_range(2000, 1000)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`to must not be less than from`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::pfsense-v2::format_port_range(0, null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`between 1 and 65535`),
+			},
+		},
+	})
+}
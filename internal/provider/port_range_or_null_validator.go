@@ -3,68 +3,42 @@ package provider
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-// PortRangeOrNullValidator validates that a string is either "null" or a number in the range 1-65535.
+// PortRangeOrNullValidator validates that a string is either "null", a number in the range 1-65535,
+// a range of two such numbers, or the name of a port alias.
 
 type PortRangeOrNullValidator struct{}
 
 func (v PortRangeOrNullValidator) Description(ctx context.Context) string {
-	return "Set to `null` to allow any destination port.<br>Other valid options are: a TCP/UDP port number, a TCP/UDP port range separated by `:`."
+	return "Set to `null` to allow any destination port.<br>Other valid options are: a TCP/UDP port number, a TCP/UDP port range separated by `:`, an existing port type firewall alias."
 }
 
 func (v PortRangeOrNullValidator) MarkdownDescription(ctx context.Context) string {
-	return "Set to `null` to allow any destination port.<br>Other valid options are: a TCP/UDP port number, a TCP/UDP port range separated by `:`."
+	return "Set to `null` to allow any destination port.<br>Other valid options are: a TCP/UDP port number, a TCP/UDP port range separated by `:`, an existing port type firewall alias."
 }
 
 func (v PortRangeOrNullValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
-	val := req.ConfigValue.ValueString()
-	if val == "null" {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
 
-	portRangeRe := regexp.MustCompile(`^(\d+):(\d+)$`)
-	portRangeMatch := portRangeRe.FindStringSubmatch(val)
-	// Single port number
-	if portRangeMatch != nil {
-		_, err := PortNumber(portRangeMatch[1])
-		if err != nil {
-			resp.Diagnostics.Append(
-				diag.NewAttributeErrorDiagnostic(
-					req.Path,
-					fmt.Sprintf("Invalid port number in range: %s", portRangeMatch[1]),
-					"Value must be a number between 1 and 65535.",
-				),
-			)
-			return
-		}
-		_, err = PortNumber(portRangeMatch[2])
-		if err != nil {
-			resp.Diagnostics.Append(
-				diag.NewAttributeErrorDiagnostic(
-					req.Path,
-					fmt.Sprintf("Invalid port number in range: %s", portRangeMatch[2]),
-					"Value must be a number between 1 and 65535.",
-				),
-			)
-			return
-		}
+	value := req.ConfigValue.ValueString()
+	if isPortAlias(value) {
 		return
 	}
 
-	// Single port number
-	_, err := strconv.Atoi(val)
+	_, err := ParsePortRange(value)
 	if err != nil {
 		resp.Diagnostics.Append(
 			diag.NewAttributeErrorDiagnostic(
 				req.Path,
 				"Invalid port value",
-				err.Error(),
+				err.Error()+". Value must be `null`, a number between 1 and 65535, two such numbers separated by `:`, or the name of a port alias.",
 			),
 		)
 	}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParsePortRange(t *testing.T) {
+	cases := []struct {
+		value string
+		want  PortRange
+	}{
+		{"null", PortRange{Any: true}},
+		{"80", PortRange{From: 80, To: 80}},
+		{"1000:2000", PortRange{From: 1000, To: 2000}},
+		{"1:65535", PortRange{From: 1, To: 65535}},
+	}
+	for _, c := range cases {
+		got, err := ParsePortRange(c.value)
+		if err != nil || got != c.want {
+			t.Errorf("ParsePortRange(%q) = %+v, %v, want %+v", c.value, got, err, c.want)
+		}
+		if got.String() != c.value {
+			t.Errorf("%+v.String() = %q, want %q", got, got.String(), c.value)
+		}
+	}
+
+	for _, value := range []string{"", "0", "65536", "http", "80:", ":80", "2000:1000", "1:2:3"} {
+		if _, err := ParsePortRange(value); err == nil {
+			t.Errorf("ParsePortRange(%q) succeeded, want error", value)
+		}
+	}
+}
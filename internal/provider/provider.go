@@ -34,11 +34,14 @@ type ScaffoldingProvider struct {
 
 // ScaffoldingProviderModel describes the provider data model.
 type ScaffoldingProviderModel struct {
-	URL               types.String `tfsdk:"url"`
-	Insecure          types.Bool   `tfsdk:"insecure"`
-	APIClientUsername types.String `tfsdk:"api_client_username"`
-	APIClientPassword types.String `tfsdk:"api_client_password"`
-	APIClientToken    types.String `tfsdk:"api_client_token"`
+	URL                types.String `tfsdk:"url"`
+	Insecure           types.Bool   `tfsdk:"insecure"`
+	APIClientUsername  types.String `tfsdk:"api_client_username"`
+	APIClientPassword  types.String `tfsdk:"api_client_password"`
+	APIClientToken     types.String `tfsdk:"api_client_token"`
+	ValidateReferences types.Bool   `tfsdk:"validate_references"`
+	SafetyCheck        types.Bool   `tfsdk:"safety_check"`
+	ConfigSnapshot     types.Bool   `tfsdk:"config_snapshot"`
 }
 
 func (p *ScaffoldingProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -54,7 +57,7 @@ func (p *ScaffoldingProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Optional:            true,
 			},
 			"insecure": schema.BoolAttribute{
-				MarkdownDescription: "Allow insecure server connections when using SSL",
+				MarkdownDescription: "Skip verification of the device's TLS certificate, e.g. while the webGUI serves its default self-signed certificate",
 				Optional:            true,
 			},
 			"api_client_username": schema.StringAttribute{
@@ -71,6 +74,28 @@ func (p *ScaffoldingProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"validate_references": schema.BoolAttribute{
+				MarkdownDescription: "Check at plan time that the aliases and interfaces named by rules and port forwards exist on the device. " +
+					"Aliases created in the same run are recognized when the rule refers to the alias resource's `name`. " +
+					"Interface groups are not recognized. Defaults to `false`; can also be set with the `PFSENSEV2_VALIDATE_REFERENCES` environment variable.",
+				Optional: true,
+			},
+			"safety_check": schema.BoolAttribute{
+				MarkdownDescription: "Guard against changes that cut the provider off from the API. Before firewall, interface and webGUI changes take effect, " +
+					"check that the anti-lockout rule or a pass rule still lets the API's port through on the interface whose address `url` points at, and refuse the change otherwise. " +
+					"After they take effect, probe the API over a new connection and, if it does not answer, restore the last configuration that left it reachable, " +
+					"reverting only the changes made since the previous change that passed the probe and naming each of them in the error. " +
+					"Requires `url` to use an interface address (or a name resolving to one). Defaults to `false`; can also be set with the `PFSENSEV2_SAFETY_CHECK` environment variable.",
+				Optional: true,
+			},
+			"config_snapshot": schema.BoolAttribute{
+				MarkdownDescription: "Record the configuration as a revision described as `Snapshot before Terraform changes` before the first change of each run, " +
+					"so a bad run can be reverted with `pfsense-v2_config_restore`. The snapshot is taken through the diagnostics command prompt endpoint, " +
+					"so the API user needs its privilege; every change fails while the snapshot cannot be taken. " +
+					"Defaults to `false` on purpose: that privilege amounts to a root shell on the device, so snapshots are only taken where it has been granted deliberately. " +
+					"Can also be set with the `PFSENSEV2_CONFIG_SNAPSHOT` environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -159,6 +184,72 @@ func ConfiguredInsecure(config *ScaffoldingProviderModel, resp *provider.Configu
 	return insecure
 }
 
+func ConfiguredValidateReferences(config *ScaffoldingProviderModel, resp *provider.ConfigureResponse) bool {
+	const title = "Unknown PFSenseV2 Validate References Flag"
+	const detail = "The provider cannot determine whether to validate references as there is an unknown validate_references flag provided. " +
+		"Please check the configuration value or use the PFSENSEV2_VALIDATE_REFERENCES environment variable."
+
+	if config.ValidateReferences.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("validate_references"), title, detail)
+	}
+
+	validate := false
+
+	if len(os.Getenv("PFSENSEV2_VALIDATE_REFERENCES")) > 0 && strings.ToLower(os.Getenv("PFSENSEV2_VALIDATE_REFERENCES")) != "false" {
+		validate = true
+	}
+
+	if !config.ValidateReferences.IsNull() {
+		validate = config.ValidateReferences.ValueBool()
+	}
+
+	return validate
+}
+
+func ConfiguredSafetyCheck(config *ScaffoldingProviderModel, resp *provider.ConfigureResponse) bool {
+	const title = "Unknown PFSenseV2 Safety Check Flag"
+	const detail = "The provider cannot determine whether to enable the safety check as there is an unknown safety_check flag provided. " +
+		"Please check the configuration value or use the PFSENSEV2_SAFETY_CHECK environment variable."
+
+	if config.SafetyCheck.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("safety_check"), title, detail)
+	}
+
+	check := false
+
+	if len(os.Getenv("PFSENSEV2_SAFETY_CHECK")) > 0 && strings.ToLower(os.Getenv("PFSENSEV2_SAFETY_CHECK")) != "false" {
+		check = true
+	}
+
+	if !config.SafetyCheck.IsNull() {
+		check = config.SafetyCheck.ValueBool()
+	}
+
+	return check
+}
+
+func ConfiguredConfigSnapshot(config *ScaffoldingProviderModel, resp *provider.ConfigureResponse) bool {
+	const title = "Unknown PFSenseV2 Config Snapshot Flag"
+	const detail = "The provider cannot determine whether to take configuration snapshots as there is an unknown config_snapshot flag provided. " +
+		"Please check the configuration value or use the PFSENSEV2_CONFIG_SNAPSHOT environment variable."
+
+	if config.ConfigSnapshot.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("config_snapshot"), title, detail)
+	}
+
+	snapshot := false
+
+	if len(os.Getenv("PFSENSEV2_CONFIG_SNAPSHOT")) > 0 && strings.ToLower(os.Getenv("PFSENSEV2_CONFIG_SNAPSHOT")) != "false" {
+		snapshot = true
+	}
+
+	if !config.ConfigSnapshot.IsNull() {
+		snapshot = config.ConfigSnapshot.ValueBool()
+	}
+
+	return snapshot
+}
+
 func (p *ScaffoldingProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config ScaffoldingProviderModel
 
@@ -170,6 +261,9 @@ func (p *ScaffoldingProvider) Configure(ctx context.Context, req provider.Config
 	url := ConfiguredURL(&config, resp)
 	auth := ConfiguredAuth(&config, resp)
 	insecure := ConfiguredInsecure(&config, resp)
+	validateReferences := ConfiguredValidateReferences(&config, resp)
+	safetyCheck := ConfiguredSafetyCheck(&config, resp)
+	configSnapshot := ConfiguredConfigSnapshot(&config, resp)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -185,31 +279,79 @@ func (p *ScaffoldingProvider) Configure(ctx context.Context, req provider.Config
 		)
 		return
 	}
+	client.ValidateReferences = validateReferences
+	if configSnapshot {
+		client.EnableConfigSnapshot()
+	}
+	if safetyCheck {
+		if err := client.EnableSafetyCheck(); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Enable Safety Check",
+				"The safety check needs the configuration history to roll back a change that locks the provider out, but reading it failed: "+
+					err.Error(),
+			)
+			return
+		}
+	}
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *ScaffoldingProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewExampleResource,
+		NewFirewallRuleResource,
+		NewFirewallFloatingRuleResource,
+		NewFirewallAliasResource,
+		NewFirewallScheduleResource,
+		NewTrafficLimiterResource,
+		NewTrafficShaperResource,
+		NewNATPortForwardResource,
+		NewDHCPStaticMappingResource,
+		NewDNSResolverHostOverrideResource,
+		NewDNSResolverDomainOverrideResource,
+		NewDNSResolverAccessListResource,
+		NewDNSResolverSettingsResource,
+		NewInterfaceResource,
+		NewSystemHostnameResource,
+		NewSystemDNSResource,
+		NewSystemWebGUIResource,
+		NewSystemSSHResource,
+		NewConfigRestoreResource,
+		NewSystemTunableResource,
+		NewSystemAdvancedFirewallResource,
+		NewSystemAdvancedNetworkingResource,
+		NewPackageResource,
+		NewRESTAPISettingsResource,
+		NewUserResource,
+		NewGroupResource,
+		NewCertificateAuthorityResource,
+		NewCertificateResource,
 	}
 }
 
 func (p *ScaffoldingProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
 		NewExampleEphemeralResource,
+		NewConfigBackupEphemeralResource,
 	}
 }
 
 func (p *ScaffoldingProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewPFSenseDataSource,
+		NewFirewallStatesDataSource,
+		NewConfigHistoryDataSource,
+		NewPackagesDataSource,
 	}
 }
 
 func (p *ScaffoldingProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		NewExampleFunction,
+		NewPortRangeFunction,
+		NewFormatPortRangeFunction,
+		NewParseAddressFunction,
 	}
 }
 
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// referenceAttributes names the top-level attributes of a resource that refer
+// to aliases or interfaces by name.
+type referenceAttributes struct {
+	// Addresses hold address expressions, which name interfaces or
+	// host and network aliases.
+	Addresses []string
+	// Ports hold port values, which may name port aliases.
+	Ports []string
+	// Interfaces hold interface IDs, either as a string or a list of strings.
+	Interfaces []string
+	// InterfaceLists is set when Interfaces are list attributes.
+	InterfaceLists bool
+}
+
+// checkReferences raises a plan-time error for every attribute that names an
+// alias or interface the device does not have. Unknown values are skipped,
+// since they are usually names of objects created in the same run.
+func checkReferences(ctx context.Context, client *pfsense_rest_v2.PFSenseClientV2, plan tfsdk.Plan, attrs referenceAttributes, diags *diag.Diagnostics) {
+	refs, err := client.References()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list aliases and interfaces to validate references, got error: %s", err))
+		return
+	}
+
+	for _, name := range attrs.Addresses {
+		var value types.String
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &value)...)
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		expr, err := ParseAddressExpression(value.ValueString())
+		if err != nil {
+			continue
+		}
+		if expr.Kind != AddressKindInterface && expr.Kind != AddressKindAlias {
+			continue
+		}
+		reportUnknownReferences(diags, name, unknownAddressNames(refs, expr))
+	}
+
+	for _, name := range attrs.Ports {
+		var value types.String
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &value)...)
+		if value.IsNull() || value.IsUnknown() || !isPortAlias(value.ValueString()) {
+			continue
+		}
+		reportUnknownReferences(diags, name, unknownPortNames(refs, value.ValueString()))
+	}
+
+	for _, name := range attrs.Interfaces {
+		var values []types.String
+		if attrs.InterfaceLists {
+			var list types.List
+			diags.Append(plan.GetAttribute(ctx, path.Root(name), &list)...)
+			if list.IsNull() || list.IsUnknown() {
+				continue
+			}
+			diags.Append(list.ElementsAs(ctx, &values, false)...)
+		} else {
+			var value types.String
+			diags.Append(plan.GetAttribute(ctx, path.Root(name), &value)...)
+			values = []types.String{value}
+		}
+
+		var unknown []string
+		for _, value := range values {
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+			if !refs.Interfaces[value.ValueString()] {
+				unknown = append(unknown, value.ValueString())
+			}
+		}
+		reportUnknownReferences(diags, name, unknown)
+	}
+}
+
+// checkGateway raises a plan-time error when the `gateway` attribute names a
+// gateway or gateway group the device does not have. Unlike the checks of
+// checkReferences it always runs, since routing traffic through a missing
+// gateway silently falls back to the routing table.
+func checkGateway(ctx context.Context, client *pfsense_rest_v2.PFSenseClientV2, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	var gateway types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("gateway"), &gateway)...)
+	if gateway.IsNull() || gateway.IsUnknown() {
+		return
+	}
+
+	names, err := client.GatewayNames()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list gateways to validate `gateway`, got error: %s", err))
+		return
+	}
+	if !names[gateway.ValueString()] {
+		diags.AddAttributeError(
+			path.Root("gateway"),
+			"Unknown Reference",
+			fmt.Sprintf("`gateway` refers to %q, which is neither a gateway nor a gateway group on the pfSense device.", gateway.ValueString()),
+		)
+	}
+}
+
+// unknownAddressNames returns the name an address expression refers to when
+// it is neither an interface nor a host or network alias. Interface names with
+// the `:ip` modifier must be interfaces.
+func unknownAddressNames(refs *pfsense_rest_v2.References, expr AddressExpression) []string {
+	if refs.Interfaces[expr.Value] {
+		return nil
+	}
+	if expr.InterfaceIP {
+		return []string{fmt.Sprintf("%s (not an interface)", expr.Value)}
+	}
+	aliasType, ok := refs.Aliases[expr.Value]
+	if !ok {
+		return []string{expr.Value}
+	}
+	if aliasType == string(pfsense_rest_v2.FirewallAliasTypePort) {
+		return []string{fmt.Sprintf("%s (a port alias)", expr.Value)}
+	}
+	return nil
+}
+
+// unknownPortNames returns the alias a port value refers to when it is not a
+// port alias.
+func unknownPortNames(refs *pfsense_rest_v2.References, name string) []string {
+	aliasType, ok := refs.Aliases[name]
+	if !ok {
+		return []string{name}
+	}
+	if aliasType != string(pfsense_rest_v2.FirewallAliasTypePort) {
+		return []string{fmt.Sprintf("%s (a %s alias)", name, aliasType)}
+	}
+	return nil
+}
+
+func reportUnknownReferences(diags *diag.Diagnostics, attribute string, names []string) {
+	if len(names) == 0 {
+		return
+	}
+	slices.Sort(names)
+	diags.AddAttributeError(
+		path.Root(attribute),
+		"Unknown Reference",
+		fmt.Sprintf("`%s` refers to aliases or interfaces that do not exist on the pfSense device or cannot be used here: %s. "+
+			"Create them first, or refer to the resource that creates them so Terraform plans it first.",
+			attribute, strings.Join(names, ", ")),
+	)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+)
+
+func TestUnknownReferenceNames(t *testing.T) {
+	refs := &pfsense_rest_v2.References{
+		Aliases: map[string]string{
+			"web_servers": "host",
+			"web_ports":   "port",
+		},
+		Interfaces: map[string]bool{"wan": true, "lan": true},
+	}
+
+	addresses := []struct {
+		value   string
+		unknown []string
+	}{
+		{"lan", nil},
+		{"!lan:ip", nil},
+		{"web_servers", nil},
+		{"opt1", []string{"opt1"}},
+		{"web_server", []string{"web_server"}},
+		{"web_servers:ip", []string{"web_servers (not an interface)"}},
+		{"web_ports", []string{"web_ports (a port alias)"}},
+	}
+	for _, c := range addresses {
+		expr, err := ParseAddressExpression(c.value)
+		if err != nil {
+			t.Fatalf("ParseAddressExpression(%q): %s", c.value, err)
+		}
+		if got := unknownAddressNames(refs, expr); !slices.Equal(got, c.unknown) {
+			t.Errorf("unknownAddressNames(%q) = %q, want %q", c.value, got, c.unknown)
+		}
+	}
+
+	ports := []struct {
+		value   string
+		unknown []string
+	}{
+		{"web_ports", nil},
+		{"web_port", []string{"web_port"}},
+		{"web_servers", []string{"web_servers (a host alias)"}},
+	}
+	for _, c := range ports {
+		if got := unknownPortNames(refs, c.value); !slices.Equal(got, c.unknown) {
+			t.Errorf("unknownPortNames(%q) = %q, want %q", c.value, got, c.unknown)
+		}
+	}
+}
+
+func TestIsPortAlias(t *testing.T) {
+	for value, want := range map[string]bool{
+		"web_ports": true,
+		"80":        false,
+		"1000:2000": false,
+		"null":      false,
+		"web-ports": false,
+	} {
+		if got := isPortAlias(value); got != want {
+			t.Errorf("isPortAlias(%q) = %t, want %t", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RESTAPISettingsResource{}
+var _ resource.ResourceWithImportState = &RESTAPISettingsResource{}
+
+const restAPISettingsID = "restapi_settings"
+
+func NewRESTAPISettingsResource() resource.Resource {
+	return &RESTAPISettingsResource{}
+}
+
+// RESTAPISettingsResource defines the resource implementation.
+type RESTAPISettingsResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// RESTAPISettingsResourceModel describes the resource data model.
+type RESTAPISettingsResourceModel struct {
+	ID                         types.String   `tfsdk:"id"`
+	AuthMethods                types.List     `tfsdk:"auth_methods"`
+	AllowedInterfaces          []types.String `tfsdk:"allowed_interfaces"`
+	ReadOnly                   types.Bool     `tfsdk:"read_only"`
+	JWTExpiry                  types.Int64    `tfsdk:"jwt_expiry"`
+	LoginProtection            types.Bool     `tfsdk:"login_protection"`
+	HASync                     types.Bool     `tfsdk:"ha_sync"`
+	HASyncValidateCertificates types.Bool     `tfsdk:"ha_sync_validate_certificates"`
+	HASyncHosts                []types.String `tfsdk:"ha_sync_hosts"`
+	HASyncUsername             types.String   `tfsdk:"ha_sync_username"`
+	HASyncPasswordWO           types.String   `tfsdk:"ha_sync_password_wo"`
+	HASyncPasswordWOVersion    types.Int64    `tfsdk:"ha_sync_password_wo_version"`
+}
+
+// toDomain builds the settings without the HA sync password; callers add it
+// only when it has to be sent. Unknown authentication methods are left empty.
+func (m *RESTAPISettingsResourceModel) toDomain(ctx context.Context, diags *diag.Diagnostics) *pfsense_rest_v2.PFSenseRESTAPISettings {
+	authMethods := []string{}
+	if !m.AuthMethods.IsUnknown() {
+		diags.Append(m.AuthMethods.ElementsAs(ctx, &authMethods, false)...)
+	}
+	return &pfsense_rest_v2.PFSenseRESTAPISettings{
+		AuthMethods:                authMethods,
+		AllowedInterfaces:          stringsFromValues(m.AllowedInterfaces),
+		ReadOnly:                   m.ReadOnly.ValueBool(),
+		JWTExpiry:                  int(m.JWTExpiry.ValueInt64()),
+		LoginProtection:            m.LoginProtection.ValueBool(),
+		HASync:                     m.HASync.ValueBool(),
+		HASyncValidateCertificates: m.HASyncValidateCertificates.ValueBool(),
+		HASyncHosts:                stringsFromValues(m.HASyncHosts),
+		HASyncUsername:             m.HASyncUsername.ValueString(),
+	}
+}
+
+// fromDomain copies the settings into the model. The HA sync password cannot
+// be read back, so the password attributes are left as they are.
+func (m *RESTAPISettingsResourceModel) fromDomain(ctx context.Context, settings *pfsense_rest_v2.PFSenseRESTAPISettings, diags *diag.Diagnostics) {
+	var listDiags diag.Diagnostics
+	m.ID = types.StringValue(restAPISettingsID)
+	m.AuthMethods, listDiags = types.ListValueFrom(ctx, types.StringType, settings.AuthMethods)
+	diags.Append(listDiags...)
+	m.AllowedInterfaces = stringValues(settings.AllowedInterfaces)
+	m.ReadOnly = types.BoolValue(settings.ReadOnly)
+	m.JWTExpiry = types.Int64Value(int64(settings.JWTExpiry))
+	m.LoginProtection = types.BoolValue(settings.LoginProtection)
+	m.HASync = types.BoolValue(settings.HASync)
+	m.HASyncValidateCertificates = types.BoolValue(settings.HASyncValidateCertificates)
+	m.HASyncHosts = stringValues(settings.HASyncHosts)
+	m.HASyncUsername = types.StringValue(settings.HASyncUsername)
+}
+
+func (r *RESTAPISettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restapi_settings"
+}
+
+func (r *RESTAPISettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptyList := listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{}))
+
+	attributes := map[string]schema.Attribute{
+		"id": singletonIDAttribute(restAPISettingsID),
+		"auth_methods": schema.ListAttribute{
+			MarkdownDescription: "Authentication methods the API accepts: `BasicAuth`, `JWTAuth` and `KeyAuth`. Leave unset to keep the current methods. " +
+				"Leaving out the method the provider itself uses is refused.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf("BasicAuth", "JWTAuth", "KeyAuth")),
+			},
+		},
+		"allowed_interfaces": schema.ListAttribute{
+			MarkdownDescription: "Interfaces the API answers on, by pfSense name, e.g. `lan`. Empty allows all interfaces. " +
+				"Leaving out the interface the provider reaches the API on is refused.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     emptyList,
+		},
+		"read_only": schema.BoolAttribute{
+			MarkdownDescription: "Whether the API rejects every change. Once it is set the provider cannot change anything, this setting included, until read-only mode is turned off in the webGUI.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"jwt_expiry": schema.Int64Attribute{
+			MarkdownDescription: "How long a JWT stays valid, in seconds",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(3600),
+			Validators: []validator.Int64{
+				int64validator.Between(300, 86400),
+			},
+		},
+		"login_protection": schema.BoolAttribute{
+			MarkdownDescription: "Whether repeated failed authentications block the client address, as they do for the webGUI",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"ha_sync": schema.BoolAttribute{
+			MarkdownDescription: "Whether the settings, API keys and JWT secret are copied to `ha_sync_hosts`",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"ha_sync_validate_certificates": schema.BoolAttribute{
+			MarkdownDescription: "Whether HA sync verifies the certificates of `ha_sync_hosts`",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"ha_sync_hosts": schema.ListAttribute{
+			MarkdownDescription: "Addresses of the HA peers to sync to",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             emptyList,
+		},
+		"ha_sync_username": schema.StringAttribute{
+			MarkdownDescription: "User HA sync authenticates as on the peers",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+	}
+	for name, attribute := range writeOnlyAttributes("ha_sync_password", "Password of `ha_sync_username`") {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Settings of the REST API package the provider talks through. There is one instance per device: creating the resource overwrites the current settings, and destroying it leaves them in place. Can be imported with any ID.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *RESTAPISettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RESTAPISettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RESTAPISettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password := writeOnlyValue(ctx, req.Config, "ha_sync_password", &resp.Diagnostics)
+	r.update(ctx, &data, password, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "took over the REST API settings")
+}
+
+func (r *RESTAPISettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RESTAPISettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetRESTAPISettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read REST API settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(ctx, settings, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RESTAPISettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RESTAPISettingsResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is only sent when it changed, since it cannot be compared with the device
+	password := ""
+	if writeOnlyVersionChanged(data.HASyncPasswordWOVersion, state.HASyncPasswordWOVersion) {
+		password = writeOnlyValue(ctx, req.Config, "ha_sync_password", &resp.Diagnostics)
+	}
+	r.update(ctx, &data, password, &resp.State, &resp.Diagnostics)
+}
+
+// update writes the planned settings, with password as the HA sync password
+// when it is not empty. Create and Update are the same operation for a
+// settings resource.
+func (r *RESTAPISettingsResource) update(ctx context.Context, data *RESTAPISettingsResourceModel, password string, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings := data.toDomain(ctx, diags)
+	settings.HASyncPassword = password
+	if diags.HasError() {
+		return
+	}
+	if data.AuthMethods.IsUnknown() {
+		current, err := r.client.GetRESTAPISettings()
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read REST API settings, got error: %s", err))
+			return
+		}
+		settings.AuthMethods = current.AuthMethods
+	}
+
+	settings, err := r.client.UpdateRESTAPISettings(settings)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update REST API settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(ctx, settings, diags)
+
+	if settings.ReadOnly {
+		diags.AddWarning(
+			"REST API Read-Only",
+			"The REST API now rejects every change, so the provider can only read from this device. "+
+				"Turn read-only mode off in the webGUI (System > REST API) before the next run that changes anything.",
+		)
+	}
+
+	// Save updated data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+func (r *RESTAPISettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	addSingletonDeleteWarning(&resp.Diagnostics, "REST API settings")
+}
+
+func (r *RESTAPISettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSingleton(ctx, restAPISettingsID, resp)
+}
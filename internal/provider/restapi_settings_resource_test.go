@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRESTAPISettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing. The authentication methods are kept.
+			{
+				Config: testAccRESTAPISettingsResourceConfig(7200),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_restapi_settings.test",
+						tfjsonpath.New("jwt_expiry"),
+						knownvalue.Int64Exact(7200),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_restapi_settings.test",
+						tfjsonpath.New("auth_methods"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pfsense-v2_restapi_settings.test",
+				ImportState:       true,
+				ImportStateId:     "restapi_settings",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRESTAPISettingsResourceConfig(3600),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_restapi_settings.test",
+						tfjsonpath.New("jwt_expiry"),
+						knownvalue.Int64Exact(3600),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRESTAPISettingsResourceConfig(jwtExpiry int) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_restapi_settings" "test" {
+  jwt_expiry = %[1]d
+}
+`, jwtExpiry)
+}
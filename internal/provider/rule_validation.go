@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// portProtocols are the protocols for which pfSense accepts port fields. Rules
+// and port forwards share the protocol and address family values.
+var portProtocols = map[string]bool{
+	string(pfsense_rest_v2.FirewallRuleProtocolTcp):    true,
+	string(pfsense_rest_v2.FirewallRuleProtocolUdp):    true,
+	string(pfsense_rest_v2.FirewallRuleProtocolTcpudp): true,
+}
+
+// icmpTypes are the ICMP types rules can match. Matching any type is
+// expressed by leaving the types unset rather than by pfSense's "any".
+var icmpTypes = []string{
+	"althost", "dataconv", "echorep", "echoreq", "fqdnrep", "fqdnreq", "groupqry", "grouprep",
+	"groupterm", "inforep", "inforeq", "ipv6-here", "ipv6-where", "listendone", "listenrep",
+	"listqry", "maskrep", "maskreq", "mobredir", "mobregrep", "mobregreq", "mtrace", "mtraceresp",
+	"neighbradv", "neighbrsol", "niqry", "nirep", "paramprob", "photuris", "redir", "routeradv",
+	"routersol", "routrrenum", "skip", "squench", "timerep", "timereq", "timex", "toobig", "trace",
+	"unreach", "wrurep", "wrureq",
+}
+
+// tcpFlags are the TCP flags rules can check.
+var tcpFlags = []string{"fin", "syn", "rst", "psh", "ack", "urg", "ece", "cwr"}
+
+// configStrings reads top-level string attributes from the configuration.
+// Attributes are read one at a time so unknown values elsewhere in the
+// configuration do not prevent validation.
+func configStrings(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics, names ...string) map[string]types.String {
+	values := make(map[string]types.String, len(names))
+	for _, name := range names {
+		var value types.String
+		diags.Append(config.GetAttribute(ctx, path.Root(name), &value)...)
+		values[name] = value
+	}
+	return values
+}
+
+// validatePortsForProtocol rejects port attributes on protocols other than
+// tcp, udp and tcp/udp. A null protocol matches any protocol and so cannot
+// carry ports either.
+func validatePortsForProtocol(diags *diag.Diagnostics, protocol types.String, ports map[string]types.String) {
+	if protocol.IsUnknown() || portProtocols[protocol.ValueString()] {
+		return
+	}
+
+	for name, port := range ports {
+		if port.IsNull() || port.IsUnknown() {
+			continue
+		}
+		protocolName := protocol.ValueString()
+		if protocol.IsNull() {
+			protocolName = "any"
+		}
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("`%s` can only be set when `protocol` is one of tcp, udp or tcp/udp, got protocol %s.", name, protocolName),
+		)
+	}
+}
+
+// validateAddressFamily checks literal addresses and networks against the
+// address family. IPv4 rules need IPv4 addresses, IPv6 rules need IPv6
+// addresses, and rules for both families cannot use literal addresses at
+// all. A null family is the schema default of IPv4.
+func validateAddressFamily(diags *diag.Diagnostics, family types.String, addresses map[string]types.String) {
+	if family.IsUnknown() {
+		return
+	}
+	familyName := family.ValueString()
+	if family.IsNull() {
+		familyName = string(pfsense_rest_v2.FirewallRuleIpprotocolInet)
+	}
+
+	for name, address := range addresses {
+		if address.IsNull() || address.IsUnknown() {
+			continue
+		}
+		is6, ok := literalAddressIs6(address.ValueString())
+		if !ok {
+			continue
+		}
+
+		var problem string
+		switch {
+		case familyName == string(pfsense_rest_v2.FirewallRuleIpprotocolInet46):
+			problem = "rules for both IPv4 and IPv6 cannot use IP addresses or networks; use an alias containing both instead"
+		case familyName == string(pfsense_rest_v2.FirewallRuleIpprotocolInet6) && !is6:
+			problem = "`address_family` inet6 requires an IPv6 address or network"
+		case familyName == string(pfsense_rest_v2.FirewallRuleIpprotocolInet) && is6:
+			problem = "`address_family` inet requires an IPv4 address or network"
+		default:
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("`%s` is %s, but %s.", name, address.ValueString(), problem),
+		)
+	}
+}
+
+// literalAddressIs6 reports whether an address expression is a literal IPv6
+// address or network. ok is false for anything that is not a literal.
+func literalAddressIs6(val string) (is6 bool, ok bool) {
+	expr, err := ParseAddressExpression(val)
+	if err != nil {
+		return false, false
+	}
+	switch expr.Kind {
+	case AddressKindAddress:
+		addr := netip.MustParseAddr(expr.Value)
+		return addr.Is6() && !addr.Is4In6(), true
+	case AddressKindNetwork:
+		prefix := netip.MustParsePrefix(expr.Value)
+		return prefix.Addr().Is6() && !prefix.Addr().Is4In6(), true
+	default:
+		return false, false
+	}
+}
+
+// validateFirewallRule runs the checks shared by interface and floating
+// rules.
+func validateFirewallRule(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	values := configStrings(ctx, config, diags, "type", "gateway", "protocol", "address_family", "source_port", "destination_port", "source", "destination", "dnpipe", "pdnpipe", "defaultqueue", "ackqueue", "statetype")
+	var icmpTypes, tcpFlagsOutOf, tcpFlagsSet types.List
+	var tcpFlagsAny types.Bool
+	diags.Append(config.GetAttribute(ctx, path.Root("icmptype"), &icmpTypes)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("tcp_flags_out_of"), &tcpFlagsOutOf)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("tcp_flags_set"), &tcpFlagsSet)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("tcp_flags_any"), &tcpFlagsAny)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	protocol := values["protocol"]
+	validatePortsForProtocol(diags, protocol, map[string]types.String{
+		"source_port":      values["source_port"],
+		"destination_port": values["destination_port"],
+	})
+	validateAddressFamily(diags, values["address_family"], map[string]types.String{
+		"source":      values["source"],
+		"destination": values["destination"],
+	})
+	validateTCPOptions(diags, protocol, values["statetype"], tcpFlagsAny, tcpFlagsOutOf, tcpFlagsSet)
+
+	if n, ok := knownListLength(icmpTypes); ok && n > 0 && !protocol.IsUnknown() && protocol.ValueString() != string(pfsense_rest_v2.FirewallRuleProtocolIcmp) {
+		diags.AddAttributeError(
+			path.Root("icmptype"),
+			"Invalid Attribute Combination",
+			"`icmptype` can only be set when `protocol` is icmp.",
+		)
+	}
+	if !values["pdnpipe"].IsNull() && values["dnpipe"].IsNull() {
+		diags.AddAttributeError(
+			path.Root("pdnpipe"),
+			"Invalid Attribute Combination",
+			"`pdnpipe` can only be set together with `dnpipe`.",
+		)
+	}
+	validateGateway(diags, values["gateway"], values["type"], values["address_family"])
+	if !values["ackqueue"].IsNull() && values["defaultqueue"].IsNull() {
+		diags.AddAttributeError(
+			path.Root("ackqueue"),
+			"Invalid Attribute Combination",
+			"`ackqueue` can only be set together with `defaultqueue`.",
+		)
+	}
+}
+
+// validateTCPOptions rejects TCP flags and the synproxy state type on rules
+// that do not match TCP. The flags that must be set have to be among the
+// flags checked, and matching any flags excludes checking them at all.
+func validateTCPOptions(diags *diag.Diagnostics, protocol types.String, stateType types.String, flagsAny types.Bool, flagsOutOf types.List, flagsSet types.List) {
+	isTCP := protocol.ValueString() == string(pfsense_rest_v2.FirewallRuleProtocolTcp) ||
+		protocol.ValueString() == string(pfsense_rest_v2.FirewallRuleProtocolTcpudp)
+
+	outOf, outOfKnown := knownListLength(flagsOutOf)
+	set, setKnown := knownListLength(flagsSet)
+	usesFlags := flagsAny.ValueBool() || (outOfKnown && outOf > 0) || (setKnown && set > 0)
+
+	if !protocol.IsUnknown() && !isTCP {
+		if usesFlags {
+			diags.AddAttributeError(
+				path.Root("protocol"),
+				"Invalid Attribute Combination",
+				"TCP flags can only be matched when `protocol` is tcp or tcp/udp.",
+			)
+		}
+		if stateType.ValueString() == string(pfsense_rest_v2.FirewallRuleStatetypeSynproxyState) {
+			diags.AddAttributeError(
+				path.Root("statetype"),
+				"Invalid Attribute Combination",
+				"`statetype` synproxy state can only be used when `protocol` is tcp or tcp/udp.",
+			)
+		}
+	}
+
+	if flagsAny.ValueBool() && ((outOfKnown && outOf > 0) || (setKnown && set > 0)) {
+		diags.AddAttributeError(
+			path.Root("tcp_flags_any"),
+			"Invalid Attribute Combination",
+			"`tcp_flags_any` cannot be combined with `tcp_flags_out_of` or `tcp_flags_set`.",
+		)
+	}
+
+	if !outOfKnown || !setKnown {
+		return
+	}
+	checked := map[string]bool{}
+	for _, flag := range knownListStrings(flagsOutOf) {
+		checked[flag] = true
+	}
+	for _, flag := range knownListStrings(flagsSet) {
+		if !checked[flag] {
+			diags.AddAttributeError(
+				path.Root("tcp_flags_set"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Flag %q in `tcp_flags_set` must also be listed in `tcp_flags_out_of`.", flag),
+			)
+		}
+	}
+}
+
+// knownListStrings returns the known string elements of a list.
+func knownListStrings(list types.List) []string {
+	var result []string
+	for _, element := range list.Elements() {
+		if value, ok := element.(types.String); ok && !value.IsNull() && !value.IsUnknown() {
+			result = append(result, value.ValueString())
+		}
+	}
+	return result
+}
+
+// validateGateway rejects gateways on rules that do not pass traffic and on
+// rules for both address families, since a gateway only routes one family.
+// A null family is the schema default of IPv4.
+func validateGateway(diags *diag.Diagnostics, gateway types.String, ruleType types.String, family types.String) {
+	if gateway.IsNull() {
+		return
+	}
+	if ruleType.ValueString() == string(pfsense_rest_v2.FirewallRuleTypeBlock) || ruleType.ValueString() == string(pfsense_rest_v2.FirewallRuleTypeReject) {
+		diags.AddAttributeError(
+			path.Root("gateway"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("`gateway` cannot be set on %s rules, which do not route traffic.", ruleType.ValueString()),
+		)
+	}
+	if family.ValueString() == string(pfsense_rest_v2.FirewallRuleIpprotocolInet46) {
+		diags.AddAttributeError(
+			path.Root("gateway"),
+			"Invalid Attribute Combination",
+			"`gateway` cannot be set on rules for both IPv4 and IPv6; create a rule per address family instead.",
+		)
+	}
+}
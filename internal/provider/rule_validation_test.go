@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatePortsForProtocol(t *testing.T) {
+	cases := []struct {
+		protocol types.String
+		port     types.String
+		errors   int
+	}{
+		{types.StringValue("tcp"), types.StringValue("443"), 0},
+		{types.StringValue("tcp/udp"), types.StringValue("1000:2000"), 0},
+		{types.StringValue("icmp"), types.StringNull(), 0},
+		{types.StringValue("icmp"), types.StringValue("443"), 1},
+		{types.StringNull(), types.StringValue("443"), 1},
+		{types.StringUnknown(), types.StringValue("443"), 0},
+		{types.StringValue("esp"), types.StringUnknown(), 0},
+	}
+	for _, c := range cases {
+		var diags diag.Diagnostics
+		validatePortsForProtocol(&diags, c.protocol, map[string]types.String{"destination_port": c.port})
+		if diags.ErrorsCount() != c.errors {
+			t.Errorf("protocol %s, port %s: got %d errors, want %d: %v", c.protocol, c.port, diags.ErrorsCount(), c.errors, diags)
+		}
+	}
+}
+
+func TestValidateAddressFamily(t *testing.T) {
+	cases := []struct {
+		family  types.String
+		address string
+		errors  int
+	}{
+		{types.StringNull(), "192.168.1.0/24", 0},
+		{types.StringNull(), "fd00::/64", 1},
+		{types.StringValue("inet"), "!10.0.0.1", 0},
+		{types.StringValue("inet"), "fd00::1", 1},
+		{types.StringValue("inet6"), "fd00::1", 0},
+		{types.StringValue("inet6"), "10.0.0.0/8", 1},
+		{types.StringValue("inet46"), "10.0.0.0/8", 1},
+		{types.StringValue("inet46"), "lan", 0},
+		{types.StringValue("inet6"), "web_servers", 0},
+		{types.StringUnknown(), "fd00::1", 0},
+	}
+	for _, c := range cases {
+		var diags diag.Diagnostics
+		validateAddressFamily(&diags, c.family, map[string]types.String{"source": types.StringValue(c.address)})
+		if diags.ErrorsCount() != c.errors {
+			t.Errorf("family %s, address %s: got %d errors, want %d: %v", c.family, c.address, diags.ErrorsCount(), c.errors, diags)
+		}
+	}
+}
+
+func TestValidateTCPOptions(t *testing.T) {
+	flags := func(values ...string) types.List {
+		elems := []attr.Value{}
+		for _, v := range values {
+			elems = append(elems, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	none := types.ListNull(types.StringType)
+
+	cases := []struct {
+		protocol  types.String
+		stateType types.String
+		any       types.Bool
+		outOf     types.List
+		set       types.List
+		errors    int
+	}{
+		{types.StringValue("tcp"), types.StringNull(), types.BoolNull(), flags("syn", "ack"), flags("syn"), 0},
+		{types.StringValue("tcp/udp"), types.StringValue("synproxy state"), types.BoolValue(true), none, none, 0},
+		{types.StringValue("tcp"), types.StringNull(), types.BoolNull(), flags("syn"), flags("syn", "ack"), 1},
+		{types.StringValue("tcp"), types.StringNull(), types.BoolValue(true), flags("syn"), none, 1},
+		{types.StringValue("udp"), types.StringNull(), types.BoolNull(), flags("syn"), none, 1},
+		{types.StringNull(), types.StringValue("synproxy state"), types.BoolNull(), none, none, 1},
+		{types.StringValue("icmp"), types.StringValue("sloppy state"), types.BoolNull(), none, none, 0},
+		{types.StringUnknown(), types.StringValue("synproxy state"), types.BoolValue(true), none, none, 0},
+		{types.StringValue("tcp"), types.StringNull(), types.BoolNull(), types.ListUnknown(types.StringType), flags("syn"), 0},
+	}
+	for i, c := range cases {
+		var diags diag.Diagnostics
+		validateTCPOptions(&diags, c.protocol, c.stateType, c.any, c.outOf, c.set)
+		if diags.ErrorsCount() != c.errors {
+			t.Errorf("case %d: got %d errors, want %d: %v", i, diags.ErrorsCount(), c.errors, diags)
+		}
+	}
+}
+
+func TestValidateGateway(t *testing.T) {
+	cases := []struct {
+		gateway  types.String
+		ruleType string
+		family   types.String
+		errors   int
+	}{
+		{types.StringValue("WAN2_DHCP"), "pass", types.StringNull(), 0},
+		{types.StringValue("failover"), "match", types.StringValue("inet6"), 0},
+		{types.StringNull(), "block", types.StringValue("inet46"), 0},
+		{types.StringValue("WAN2_DHCP"), "block", types.StringNull(), 1},
+		{types.StringValue("WAN2_DHCP"), "pass", types.StringValue("inet46"), 1},
+		{types.StringUnknown(), "reject", types.StringValue("inet46"), 2},
+	}
+	for _, c := range cases {
+		var diags diag.Diagnostics
+		validateGateway(&diags, c.gateway, types.StringValue(c.ruleType), c.family)
+		if diags.ErrorsCount() != c.errors {
+			t.Errorf("gateway %s on %s rule, family %s: got %d errors, want %d: %v", c.gateway, c.ruleType, c.family, diags.ErrorsCount(), c.errors, diags)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// Settings resources manage configuration that exists exactly once per device
+// and can be changed but never created or deleted. Creating one takes over the
+// current settings and overwrites them with the configuration; destroying one
+// only removes it from state and leaves the device as it is.
+
+// singletonIDAttribute is the `id` attribute of a settings resource, which
+// always holds the fixed value id.
+func singletonIDAttribute(id string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Always `%s`; there is only one instance per device", id),
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// importSingleton imports a settings resource. There is only one instance, so
+// the import ID is ignored.
+func importSingleton(ctx context.Context, id string, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// addSingletonDeleteWarning reports that destroying a settings resource did
+// not change the device.
+func addSingletonDeleteWarning(diags *diag.Diagnostics, what string) {
+	diags.AddWarning(
+		"Settings Left in Place",
+		fmt.Sprintf("The %s cannot be deleted, so the resource was only removed from Terraform state. "+
+			"The device keeps its current settings.", what),
+	)
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemAdvancedFirewallResource{}
+var _ resource.ResourceWithImportState = &SystemAdvancedFirewallResource{}
+
+const systemAdvancedFirewallID = "system_advanced_firewall"
+
+func NewSystemAdvancedFirewallResource() resource.Resource {
+	return &SystemAdvancedFirewallResource{}
+}
+
+// SystemAdvancedFirewallResource defines the resource implementation.
+type SystemAdvancedFirewallResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// SystemAdvancedFirewallResourceModel describes the resource data model.
+type SystemAdvancedFirewallResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	MaximumStates       types.Int64  `tfsdk:"maximum_states"`
+	MaximumTableEntries types.Int64  `tfsdk:"maximum_table_entries"`
+	Optimization        types.String `tfsdk:"optimization"`
+	NATReflection       types.String `tfsdk:"nat_reflection"`
+	BINATReflection     types.Bool   `tfsdk:"binat_reflection"`
+	NATReflectionHelper types.Bool   `tfsdk:"nat_reflection_outbound"`
+}
+
+func (m *SystemAdvancedFirewallResourceModel) toDomain() *pfsense_rest_v2.PFSenseAdvancedFirewallSettings {
+	return &pfsense_rest_v2.PFSenseAdvancedFirewallSettings{
+		MaximumStates:       int(m.MaximumStates.ValueInt64()),
+		MaximumTableEntries: int(m.MaximumTableEntries.ValueInt64()),
+		Optimization:        m.Optimization.ValueString(),
+		NATReflection:       m.NATReflection.ValueString(),
+		BINATReflection:     m.BINATReflection.ValueBool(),
+		NATReflectionHelper: m.NATReflectionHelper.ValueBool(),
+	}
+}
+
+func (m *SystemAdvancedFirewallResourceModel) fromDomain(settings *pfsense_rest_v2.PFSenseAdvancedFirewallSettings) {
+	m.ID = types.StringValue(systemAdvancedFirewallID)
+	m.MaximumStates = types.Int64Value(int64(settings.MaximumStates))
+	m.MaximumTableEntries = types.Int64Value(int64(settings.MaximumTableEntries))
+	m.Optimization = types.StringValue(settings.Optimization)
+	m.NATReflection = types.StringValue(settings.NATReflection)
+	m.BINATReflection = types.BoolValue(settings.BINATReflection)
+	m.NATReflectionHelper = types.BoolValue(settings.NATReflectionHelper)
+}
+
+func (r *SystemAdvancedFirewallResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_advanced_firewall"
+}
+
+func (r *SystemAdvancedFirewallResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Options from System > Advanced > Firewall & NAT. There is one instance per device: creating the resource overwrites the current settings, and destroying it leaves them in place. Can be imported with any ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": singletonIDAttribute(systemAdvancedFirewallID),
+			"maximum_states": schema.Int64Attribute{
+				MarkdownDescription: "Size of the state table. `0` sizes it from the device's memory.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"maximum_table_entries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of addresses in all tables, which hold aliases and bogon networks. `0` sizes it from the device's memory.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"optimization": schema.StringAttribute{
+				MarkdownDescription: "How long idle states are kept: `normal`, `high-latency`, `aggressive` or `conservative`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("normal"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("normal", "high-latency", "aggressive", "conservative"),
+				},
+			},
+			"nat_reflection": schema.StringAttribute{
+				MarkdownDescription: "NAT reflection for port forwards: `disable`, `proxy` for NAT + proxy or `purenat` for pure NAT. Port forwards can override it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("proxy"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("disable", "proxy", "purenat"),
+				},
+			},
+			"binat_reflection": schema.BoolAttribute{
+				MarkdownDescription: "Whether NAT reflection applies to 1:1 NAT mappings",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"nat_reflection_outbound": schema.BoolAttribute{
+				MarkdownDescription: "Whether pure NAT reflection also creates the outbound NAT rules that let hosts reach port forwards on their own subnet",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *SystemAdvancedFirewallResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemAdvancedFirewallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemAdvancedFirewallResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "took over the advanced firewall settings")
+}
+
+func (r *SystemAdvancedFirewallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemAdvancedFirewallResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetAdvancedFirewallSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read advanced firewall settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedFirewallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemAdvancedFirewallResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+// update writes the planned settings. Create and Update are the same
+// operation for a settings resource.
+func (r *SystemAdvancedFirewallResource) update(ctx context.Context, data *SystemAdvancedFirewallResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings, err := r.client.UpdateAdvancedFirewallSettings(data.toDomain())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update advanced firewall settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	// Save updated data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+func (r *SystemAdvancedFirewallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	addSingletonDeleteWarning(&resp.Diagnostics, "advanced firewall settings")
+}
+
+func (r *SystemAdvancedFirewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSingleton(ctx, systemAdvancedFirewallID, resp)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccSystemAdvancedFirewallResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "pfsense-v2_system_advanced_firewall" "test" {
+  maximum_states = 200000
+  nat_reflection = "purenat"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_advanced_firewall.test",
+						tfjsonpath.New("maximum_states"),
+						knownvalue.Int64Exact(200000),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_advanced_firewall.test",
+						tfjsonpath.New("optimization"),
+						knownvalue.StringExact("normal"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pfsense-v2_system_advanced_firewall.test",
+				ImportState:       true,
+				ImportStateId:     "system_advanced_firewall",
+				ImportStateVerify: true,
+			},
+			// Back to the defaults
+			{
+				Config: `
+resource "pfsense-v2_system_advanced_firewall" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_advanced_firewall.test",
+						tfjsonpath.New("nat_reflection"),
+						knownvalue.StringExact("proxy"),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemAdvancedNetworkingResource{}
+var _ resource.ResourceWithImportState = &SystemAdvancedNetworkingResource{}
+
+const systemAdvancedNetworkingID = "system_advanced_networking"
+
+func NewSystemAdvancedNetworkingResource() resource.Resource {
+	return &SystemAdvancedNetworkingResource{}
+}
+
+// SystemAdvancedNetworkingResource defines the resource implementation.
+type SystemAdvancedNetworkingResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// SystemAdvancedNetworkingResourceModel describes the resource data model.
+type SystemAdvancedNetworkingResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	AllowIPv6              types.Bool   `tfsdk:"allow_ipv6"`
+	PreferIPv4             types.Bool   `tfsdk:"prefer_ipv4"`
+	ChecksumOffloading     types.Bool   `tfsdk:"checksum_offloading"`
+	SegmentationOffloading types.Bool   `tfsdk:"segmentation_offloading"`
+	LargeReceiveOffloading types.Bool   `tfsdk:"large_receive_offloading"`
+}
+
+func (m *SystemAdvancedNetworkingResourceModel) toDomain() *pfsense_rest_v2.PFSenseAdvancedNetworkingSettings {
+	return &pfsense_rest_v2.PFSenseAdvancedNetworkingSettings{
+		AllowIPv6:              m.AllowIPv6.ValueBool(),
+		PreferIPv4:             m.PreferIPv4.ValueBool(),
+		ChecksumOffloading:     m.ChecksumOffloading.ValueBool(),
+		SegmentationOffloading: m.SegmentationOffloading.ValueBool(),
+		LargeReceiveOffloading: m.LargeReceiveOffloading.ValueBool(),
+	}
+}
+
+func (m *SystemAdvancedNetworkingResourceModel) fromDomain(settings *pfsense_rest_v2.PFSenseAdvancedNetworkingSettings) {
+	m.ID = types.StringValue(systemAdvancedNetworkingID)
+	m.AllowIPv6 = types.BoolValue(settings.AllowIPv6)
+	m.PreferIPv4 = types.BoolValue(settings.PreferIPv4)
+	m.ChecksumOffloading = types.BoolValue(settings.ChecksumOffloading)
+	m.SegmentationOffloading = types.BoolValue(settings.SegmentationOffloading)
+	m.LargeReceiveOffloading = types.BoolValue(settings.LargeReceiveOffloading)
+}
+
+func (r *SystemAdvancedNetworkingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_advanced_networking"
+}
+
+func (r *SystemAdvancedNetworkingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Options from System > Advanced > Networking. There is one instance per device: creating the resource overwrites the current settings, and destroying it leaves them in place. Can be imported with any ID.\n\n" +
+			"Offloading changes take effect when the interfaces are next configured, e.g. after a reboot.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": singletonIDAttribute(systemAdvancedNetworkingID),
+			"allow_ipv6": schema.BoolAttribute{
+				MarkdownDescription: "Whether the firewall passes IPv6 traffic",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"prefer_ipv4": schema.BoolAttribute{
+				MarkdownDescription: "Whether the device itself connects over IPv4 to hosts that also have an IPv6 address",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"checksum_offloading": schema.BoolAttribute{
+				MarkdownDescription: "Whether network cards compute checksums. Some cards and virtual NICs corrupt packets with it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"segmentation_offloading": schema.BoolAttribute{
+				MarkdownDescription: "Whether network cards split large outgoing packets (TSO). pfSense disables it by default, as it breaks forwarding on many cards.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"large_receive_offloading": schema.BoolAttribute{
+				MarkdownDescription: "Whether network cards merge incoming packets (LRO). pfSense disables it by default, as it breaks forwarding on many cards.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *SystemAdvancedNetworkingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemAdvancedNetworkingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemAdvancedNetworkingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "took over the advanced networking settings")
+}
+
+func (r *SystemAdvancedNetworkingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemAdvancedNetworkingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetAdvancedNetworkingSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read advanced networking settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedNetworkingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemAdvancedNetworkingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+// update writes the planned settings. Create and Update are the same
+// operation for a settings resource.
+func (r *SystemAdvancedNetworkingResource) update(ctx context.Context, data *SystemAdvancedNetworkingResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings, err := r.client.UpdateAdvancedNetworkingSettings(data.toDomain())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update advanced networking settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	// Save updated data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+func (r *SystemAdvancedNetworkingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	addSingletonDeleteWarning(&resp.Diagnostics, "advanced networking settings")
+}
+
+func (r *SystemAdvancedNetworkingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSingleton(ctx, systemAdvancedNetworkingID, resp)
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemDNSResource{}
+var _ resource.ResourceWithImportState = &SystemDNSResource{}
+
+const systemDNSID = "system_dns"
+
+func NewSystemDNSResource() resource.Resource {
+	return &SystemDNSResource{}
+}
+
+// SystemDNSResource defines the resource implementation.
+type SystemDNSResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// SystemDNSResourceModel describes the resource data model.
+type SystemDNSResourceModel struct {
+	ID            types.String           `tfsdk:"id"`
+	Servers       []SystemDNSServerModel `tfsdk:"servers"`
+	AllowOverride types.Bool             `tfsdk:"allow_override"`
+}
+
+// SystemDNSServerModel describes one entry of servers.
+type SystemDNSServerModel struct {
+	Address types.String `tfsdk:"address"`
+	Gateway types.String `tfsdk:"gateway"`
+}
+
+func (m *SystemDNSResourceModel) toDomain() *pfsense_rest_v2.PFSenseSystemDNS {
+	dns := &pfsense_rest_v2.PFSenseSystemDNS{
+		Servers:       []pfsense_rest_v2.PFSenseSystemDNSServer{},
+		AllowOverride: m.AllowOverride.ValueBool(),
+	}
+	for _, s := range m.Servers {
+		dns.Servers = append(dns.Servers, pfsense_rest_v2.PFSenseSystemDNSServer{
+			Address: s.Address.ValueString(),
+			Gateway: s.Gateway.ValueStringPointer(),
+		})
+	}
+	return dns
+}
+
+func (m *SystemDNSResourceModel) fromDomain(dns *pfsense_rest_v2.PFSenseSystemDNS) {
+	m.ID = types.StringValue(systemDNSID)
+	m.AllowOverride = types.BoolValue(dns.AllowOverride)
+	m.Servers = []SystemDNSServerModel{}
+	for _, s := range dns.Servers {
+		m.Servers = append(m.Servers, SystemDNSServerModel{
+			Address: types.StringValue(s.Address),
+			Gateway: types.StringPointerValue(s.Gateway),
+		})
+	}
+}
+
+func (r *SystemDNSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_dns"
+}
+
+func (r *SystemDNSResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNS servers used by the device itself, and by the DNS resolver when it forwards. There is one instance per device: creating the resource overwrites the current servers, and destroying it leaves them in place. Can be imported with any ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": singletonIDAttribute(systemDNSID),
+			"servers": schema.ListNestedAttribute{
+				MarkdownDescription: "DNS servers, in the order they are queried",
+				Optional:            true,
+				Computed:            true,
+				Default: listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{
+					"address": types.StringType,
+					"gateway": types.StringType,
+				}}, []attr.Value{})),
+				Validators: []validator.List{listvalidator.SizeAtMost(8)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "IPv4 or IPv6 address of the server",
+							Required:            true,
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "Gateway the server is reached through. Leave unset to use the routing table.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"allow_override": schema.BoolAttribute{
+				MarkdownDescription: "Whether DNS servers learned by DHCP or PPP on WAN interfaces replace `servers` (pfSense `dnsallowoverride`)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *SystemDNSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemDNSResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "took over the system DNS servers")
+}
+
+func (r *SystemDNSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemDNSResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dns, err := r.client.GetSystemDNS()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system DNS, got error: %s", err))
+		return
+	}
+	data.fromDomain(dns)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemDNSResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+// update writes the planned servers. Create and Update are the same operation
+// for a settings resource.
+func (r *SystemDNSResource) update(ctx context.Context, data *SystemDNSResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	dns, err := r.client.UpdateSystemDNS(data.toDomain())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update system DNS, got error: %s", err))
+		return
+	}
+	data.fromDomain(dns)
+
+	// Save updated data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+func (r *SystemDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	addSingletonDeleteWarning(&resp.Diagnostics, "system DNS servers")
+}
+
+func (r *SystemDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSingleton(ctx, systemDNSID, resp)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccSystemDNSResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSystemDNSResourceConfig("9.9.9.9"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_dns.test",
+						tfjsonpath.New("servers").AtSliceIndex(0).AtMapKey("address"),
+						knownvalue.StringExact("9.9.9.9"),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_dns.test",
+						tfjsonpath.New("servers").AtSliceIndex(0).AtMapKey("gateway"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pfsense-v2_system_dns.test",
+				ImportState:       true,
+				ImportStateId:     "system_dns",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSystemDNSResourceConfig("1.1.1.1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_dns.test",
+						tfjsonpath.New("servers").AtSliceIndex(0).AtMapKey("address"),
+						knownvalue.StringExact("1.1.1.1"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSystemDNSResourceConfig(server string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_system_dns" "test" {
+  servers        = [{ address = %[1]q }]
+  allow_override = false
+}
+`, server)
+}
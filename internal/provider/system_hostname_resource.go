@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemHostnameResource{}
+var _ resource.ResourceWithImportState = &SystemHostnameResource{}
+
+const systemHostnameID = "system_hostname"
+
+func NewSystemHostnameResource() resource.Resource {
+	return &SystemHostnameResource{}
+}
+
+// SystemHostnameResource defines the resource implementation.
+type SystemHostnameResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// SystemHostnameResourceModel describes the resource data model.
+type SystemHostnameResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Hostname types.String `tfsdk:"hostname"`
+	Domain   types.String `tfsdk:"domain"`
+}
+
+func (m *SystemHostnameResourceModel) toDomain() *pfsense_rest_v2.PFSenseBaseConfig {
+	return &pfsense_rest_v2.PFSenseBaseConfig{
+		Hostname: m.Hostname.ValueString(),
+		Domain:   m.Domain.ValueString(),
+	}
+}
+
+func (m *SystemHostnameResourceModel) fromDomain(config *pfsense_rest_v2.PFSenseBaseConfig) {
+	m.ID = types.StringValue(systemHostnameID)
+	m.Hostname = types.StringValue(config.Hostname)
+	m.Domain = types.StringValue(config.Domain)
+}
+
+func (r *SystemHostnameResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_hostname"
+}
+
+func (r *SystemHostnameResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Hostname and domain of the device. There is one instance per device: creating the resource overwrites the current names, and destroying it leaves them in place. Can be imported with any ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": singletonIDAttribute(systemHostnameID),
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname, without the domain",
+				Required:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain of the device, also the default domain of DHCP clients and the DNS resolver",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *SystemHostnameResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemHostnameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemHostnameResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "took over the system hostname")
+}
+
+func (r *SystemHostnameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemHostnameResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetBaseConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system hostname, got error: %s", err))
+		return
+	}
+	data.fromDomain(config)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemHostnameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemHostnameResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+// update writes the planned names. Create and Update are the same operation
+// for a settings resource.
+func (r *SystemHostnameResource) update(ctx context.Context, data *SystemHostnameResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	config, err := r.client.UpdateBaseConfig(data.toDomain())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update system hostname, got error: %s", err))
+		return
+	}
+	data.fromDomain(config)
+
+	// Save updated data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+func (r *SystemHostnameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	addSingletonDeleteWarning(&resp.Diagnostics, "system hostname")
+}
+
+func (r *SystemHostnameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSingleton(ctx, systemHostnameID, resp)
+}
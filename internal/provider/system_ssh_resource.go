@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemSSHResource{}
+var _ resource.ResourceWithImportState = &SystemSSHResource{}
+
+const systemSSHID = "system_ssh"
+
+func NewSystemSSHResource() resource.Resource {
+	return &SystemSSHResource{}
+}
+
+// SystemSSHResource defines the resource implementation.
+type SystemSSHResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// SystemSSHResourceModel describes the resource data model.
+type SystemSSHResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Port            types.String `tfsdk:"port"`
+	KeyOnly         types.String `tfsdk:"key_only"`
+	AgentForwarding types.Bool   `tfsdk:"agent_forwarding"`
+}
+
+func (m *SystemSSHResourceModel) toDomain() *pfsense_rest_v2.PFSenseSSHSettings {
+	return &pfsense_rest_v2.PFSenseSSHSettings{
+		Enabled:         m.Enabled.ValueBool(),
+		Port:            m.Port.ValueString(),
+		KeyOnly:         m.KeyOnly.ValueString(),
+		AgentForwarding: m.AgentForwarding.ValueBool(),
+	}
+}
+
+func (m *SystemSSHResourceModel) fromDomain(settings *pfsense_rest_v2.PFSenseSSHSettings) {
+	m.ID = types.StringValue(systemSSHID)
+	m.Enabled = types.BoolValue(settings.Enabled)
+	m.Port = types.StringValue(settings.Port)
+	m.KeyOnly = types.StringValue(settings.KeyOnly)
+	m.AgentForwarding = types.BoolValue(settings.AgentForwarding)
+}
+
+func (r *SystemSSHResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_ssh"
+}
+
+func (r *SystemSSHResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SSH daemon settings. There is one instance per device: creating the resource overwrites the current settings, and destroying it leaves them in place. Can be imported with any ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": singletonIDAttribute(systemSSHID),
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the SSH daemon runs",
+				Required:            true,
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "Port the SSH daemon listens on",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("22"),
+				Validators: []validator.String{
+					PortNumberValidator{},
+				},
+			},
+			"key_only": schema.StringAttribute{
+				MarkdownDescription: "Authentication the daemon accepts: `disabled` for a password or a public key, `enabled` for a public key only, `both` to require a public key and a password. Keys are set with the `authorized_keys` of `pfsense-v2_user`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("disabled"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("disabled", "enabled", "both"),
+				},
+			},
+			"agent_forwarding": schema.BoolAttribute{
+				MarkdownDescription: "Whether clients may forward their SSH agent",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *SystemSSHResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemSSHResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemSSHResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "took over the SSH settings")
+}
+
+func (r *SystemSSHResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemSSHResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSSHSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SSH settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemSSHResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemSSHResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+// update writes the planned settings. Create and Update are the same
+// operation for a settings resource.
+func (r *SystemSSHResource) update(ctx context.Context, data *SystemSSHResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings, err := r.client.UpdateSSHSettings(data.toDomain())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update SSH settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	// Save updated data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+func (r *SystemSSHResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	addSingletonDeleteWarning(&resp.Diagnostics, "SSH settings")
+}
+
+func (r *SystemSSHResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSingleton(ctx, systemSSHID, resp)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccSystemSSHResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSystemSSHResourceConfig("enabled"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_ssh.test",
+						tfjsonpath.New("key_only"),
+						knownvalue.StringExact("enabled"),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_ssh.test",
+						tfjsonpath.New("port"),
+						knownvalue.StringExact("22"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pfsense-v2_system_ssh.test",
+				ImportState:       true,
+				ImportStateId:     "system_ssh",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSystemSSHResourceConfig("disabled"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_ssh.test",
+						tfjsonpath.New("key_only"),
+						knownvalue.StringExact("disabled"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSystemSSHResourceConfig(keyOnly string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_system_ssh" "test" {
+  enabled  = true
+  key_only = %[1]q
+}
+`, keyOnly)
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemTunableResource{}
+var _ resource.ResourceWithImportState = &SystemTunableResource{}
+
+// sysctlNamePattern matches a sysctl variable name such as net.inet.ip.forwarding.
+var sysctlNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_%-]+(\.[a-zA-Z0-9_%-]+)+$`)
+
+func NewSystemTunableResource() resource.Resource {
+	return &SystemTunableResource{}
+}
+
+// SystemTunableResource defines the resource implementation.
+type SystemTunableResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// SystemTunableResourceModel describes the resource data model.
+type SystemTunableResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m *SystemTunableResourceModel) toDomain() *pfsense_rest_v2.PFSenseSystemTunable {
+	return &pfsense_rest_v2.PFSenseSystemTunable{
+		ID:          int(m.ID.ValueInt64()),
+		Name:        m.Name.ValueString(),
+		Value:       m.Value.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+func (m *SystemTunableResourceModel) fromDomain(tunable *pfsense_rest_v2.PFSenseSystemTunable) {
+	m.ID = types.Int64Value(int64(tunable.ID))
+	m.Name = types.StringValue(tunable.Name)
+	m.Value = types.StringValue(tunable.Value)
+	m.Description = types.StringValue(tunable.Description)
+}
+
+func (r *SystemTunableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_tunable"
+}
+
+func (r *SystemTunableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "System tunable, a sysctl variable pfSense sets at boot and when the tunable is saved. Can be imported by pfSense ID or by sysctl name. " +
+			"pfSense lists its own defaults as tunables too; importing one and then destroying it removes the entry, and the kernel keeps its current value until the next boot.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the tunable. This is the tunable's position in the configuration and may change when other tunables are removed; the provider locates the tunable by `name` and records the new ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the sysctl variable, e.g. `net.inet.tcp.tso`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sysctlNamePattern, "must be a sysctl variable name"),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the variable",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Tunable description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (r *SystemTunableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemTunableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemTunableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunable, err := r.client.CreateSystemTunable(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tunable, got error: %s", err))
+		return
+	}
+	data.fromDomain(tunable)
+
+	tflog.Trace(ctx, "created a tunable", map[string]interface{}{"id": tunable.ID, "name": tunable.Name})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemTunableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemTunableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunable, drift, err := r.client.ResolveSystemTunable(int(data.ID.ValueInt64()), data.Name.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tunable, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(tunable)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemTunableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SystemTunableResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "tunable", func() (*pfsense_rest_v2.PFSenseSystemTunable, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveSystemTunable(int(state.ID.ValueInt64()), state.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	tunable, err := r.client.UpdateSystemTunable(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tunable, got error: %s", err))
+		return
+	}
+	data.fromDomain(tunable)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemTunableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SystemTunableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "tunable", func() (*pfsense_rest_v2.PFSenseSystemTunable, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveSystemTunable(int(data.ID.ValueInt64()), data.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteSystemTunable(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tunable, got error: %s", err))
+	}
+}
+
+func (r *SystemTunableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Sysctl names always contain a dot, so anything that is not a number is a name.
+	_, value := importKey(req.ID, "name")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		tunables, err := r.client.GetSystemTunables()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tunables, got error: %s", err))
+			return
+		}
+		tunable, err := findUnique(tunables, func(tunable *pfsense_rest_v2.PFSenseSystemTunable) bool {
+			return tunable.Name == value
+		}, fmt.Sprintf("name %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Tunable", err.Error())
+			return
+		}
+		id = tunable.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccSystemTunableResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSystemTunableResourceConfig("1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_tunable.test",
+						tfjsonpath.New("value"),
+						knownvalue.StringExact("1"),
+					),
+				},
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "pfsense-v2_system_tunable.test",
+				ImportState:       true,
+				ImportStateId:     "net.inet.tcp.tso_test_acc",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSystemTunableResourceConfig("0"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_system_tunable.test",
+						tfjsonpath.New("value"),
+						knownvalue.StringExact("0"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSystemTunableResourceConfig(value string) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_system_tunable" "test" {
+  name        = "net.inet.tcp.tso_test_acc"
+  value       = %[1]q
+  description = "Acceptance test"
+}
+`, value)
+}
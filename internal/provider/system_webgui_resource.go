@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemWebGUIResource{}
+var _ resource.ResourceWithImportState = &SystemWebGUIResource{}
+
+const systemWebGUIID = "system_webgui"
+
+func NewSystemWebGUIResource() resource.Resource {
+	return &SystemWebGUIResource{}
+}
+
+// SystemWebGUIResource defines the resource implementation.
+type SystemWebGUIResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// SystemWebGUIResourceModel describes the resource data model.
+type SystemWebGUIResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Protocol            types.String `tfsdk:"protocol"`
+	Port                types.String `tfsdk:"port"`
+	SSLCertificateRefID types.String `tfsdk:"ssl_certificate_refid"`
+	AntiLockout         types.Bool   `tfsdk:"anti_lockout"`
+	DNSRebindCheck      types.Bool   `tfsdk:"dns_rebind_check"`
+	HSTS                types.Bool   `tfsdk:"hsts"`
+}
+
+func (m *SystemWebGUIResourceModel) toDomain() *pfsense_rest_v2.PFSenseWebGUISettings {
+	return &pfsense_rest_v2.PFSenseWebGUISettings{
+		Protocol:            m.Protocol.ValueString(),
+		Port:                m.Port.ValueString(),
+		SSLCertificateRefID: m.SSLCertificateRefID.ValueString(),
+		AntiLockout:         m.AntiLockout.ValueBool(),
+		DNSRebindCheck:      m.DNSRebindCheck.ValueBool(),
+		HSTS:                m.HSTS.ValueBool(),
+	}
+}
+
+func (m *SystemWebGUIResourceModel) fromDomain(settings *pfsense_rest_v2.PFSenseWebGUISettings) {
+	m.ID = types.StringValue(systemWebGUIID)
+	m.Protocol = types.StringValue(settings.Protocol)
+	m.Port = types.StringValue(settings.Port)
+	m.SSLCertificateRefID = types.StringValue(settings.SSLCertificateRefID)
+	m.AntiLockout = types.BoolValue(settings.AntiLockout)
+	m.DNSRebindCheck = types.BoolValue(settings.DNSRebindCheck)
+	m.HSTS = types.BoolValue(settings.HSTS)
+}
+
+func (r *SystemWebGUIResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_webgui"
+}
+
+func (r *SystemWebGUIResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "How the webGUI, and with it the REST API the provider uses, is served. There is one instance per device: creating the resource overwrites the current settings, and destroying it leaves them in place. Can be imported with any ID.\n\n" +
+			"Changing `protocol` or `port` moves the API. The provider follows it to the new URL for the rest of the run and reports it in a warning; update the provider `url` before the next run.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": singletonIDAttribute(systemWebGUIID),
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol the webGUI is served over, `http` or `https`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("https"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("http", "https"),
+				},
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "Port the webGUI listens on. Leave empty for the default port of `protocol`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						PortNumberValidator{},
+					),
+				},
+			},
+			"ssl_certificate_refid": schema.StringAttribute{
+				MarkdownDescription: "Reference ID of the certificate served over https, e.g. the `refid` of a `pfsense-v2_certificate`. Leave unset to keep the current certificate.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"anti_lockout": schema.BoolAttribute{
+				MarkdownDescription: "Whether an implicit rule keeps the webGUI and SSH reachable from the LAN interface regardless of the firewall rules",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"dns_rebind_check": schema.BoolAttribute{
+				MarkdownDescription: "Whether the webGUI rejects requests for host names that do not resolve to the device, as a defence against DNS rebinding",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"hsts": schema.BoolAttribute{
+				MarkdownDescription: "Whether the webGUI sends the HTTP Strict Transport Security header over https",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *SystemWebGUIResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemWebGUIResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemWebGUIResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "took over the webGUI settings")
+}
+
+func (r *SystemWebGUIResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemWebGUIResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetWebGUISettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webGUI settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemWebGUIResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemWebGUIResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+// update writes the planned settings, following the API when it moves.
+// Create and Update are the same operation for a settings resource.
+func (r *SystemWebGUIResource) update(ctx context.Context, data *SystemWebGUIResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings := data.toDomain()
+	if data.SSLCertificateRefID.IsUnknown() {
+		current, err := r.client.GetWebGUISettings()
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read webGUI settings, got error: %s", err))
+			return
+		}
+		settings.SSLCertificateRefID = current.SSLCertificateRefID
+	}
+
+	previousURL := r.client.URL()
+	settings, err := r.client.UpdateWebGUISettings(settings)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update webGUI settings, got error: %s", err))
+		return
+	}
+	data.fromDomain(settings)
+
+	if url := r.client.URL(); url != previousURL {
+		diags.AddWarning(
+			"API URL Changed",
+			fmt.Sprintf("The webGUI moved from %s to %s and the provider now talks to the new URL. "+
+				"Set the provider `url` (or PFSENSEV2_URL) to %s before the next run.", previousURL, url, url),
+		)
+	}
+
+	// Save updated data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+func (r *SystemWebGUIResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	addSingletonDeleteWarning(&resp.Diagnostics, "webGUI settings")
+}
+
+func (r *SystemWebGUIResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSingleton(ctx, systemWebGUIID, resp)
+}
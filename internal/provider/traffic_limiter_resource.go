@@ -0,0 +1,510 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TrafficLimiterResource{}
+var _ resource.ResourceWithImportState = &TrafficLimiterResource{}
+
+func NewTrafficLimiterResource() resource.Resource {
+	return &TrafficLimiterResource{}
+}
+
+// TrafficLimiterResource defines the resource implementation.
+type TrafficLimiterResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// TrafficLimiterResourceModel describes the resource data model.
+type TrafficLimiterResourceModel struct {
+	ID          types.Int64                    `tfsdk:"id"`
+	Name        types.String                   `tfsdk:"name"`
+	Enabled     types.Bool                     `tfsdk:"enabled"`
+	Description types.String                   `tfsdk:"description"`
+	Bandwidths  []TrafficLimiterBandwidthModel `tfsdk:"bandwidths"`
+	Mask        types.String                   `tfsdk:"mask"`
+	MaskBits    types.Int64                    `tfsdk:"mask_bits"`
+	MaskBitsV6  types.Int64                    `tfsdk:"mask_bits_v6"`
+	Scheduler   types.String                   `tfsdk:"scheduler"`
+	AQM         types.String                   `tfsdk:"aqm"`
+	QueueLimit  types.Int64                    `tfsdk:"queue_limit"`
+	ECN         types.Bool                     `tfsdk:"ecn"`
+	Delay       types.Int64                    `tfsdk:"delay"`
+	Queues      []TrafficLimiterQueueModel     `tfsdk:"queues"`
+}
+
+// TrafficLimiterBandwidthModel describes one entry of bandwidths.
+type TrafficLimiterBandwidthModel struct {
+	Bandwidth types.Int64  `tfsdk:"bandwidth"`
+	Scale     types.String `tfsdk:"scale"`
+	Schedule  types.String `tfsdk:"schedule"`
+}
+
+// TrafficLimiterQueueModel describes one entry of queues.
+type TrafficLimiterQueueModel struct {
+	Name        types.String `tfsdk:"name"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Description types.String `tfsdk:"description"`
+	Mask        types.String `tfsdk:"mask"`
+	MaskBits    types.Int64  `tfsdk:"mask_bits"`
+	MaskBitsV6  types.Int64  `tfsdk:"mask_bits_v6"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	AQM         types.String `tfsdk:"aqm"`
+	QueueLimit  types.Int64  `tfsdk:"queue_limit"`
+	ECN         types.Bool   `tfsdk:"ecn"`
+}
+
+func (m *TrafficLimiterResourceModel) toDomain() *pfsense_rest_v2.PFSenseTrafficLimiter {
+	limiter := &pfsense_rest_v2.PFSenseTrafficLimiter{
+		ID:          int(m.ID.ValueInt64()),
+		Name:        m.Name.ValueString(),
+		Enabled:     m.Enabled.ValueBool(),
+		Description: m.Description.ValueString(),
+		Bandwidths:  []pfsense_rest_v2.PFSenseTrafficLimiterBandwidth{},
+		Mask:        m.Mask.ValueString(),
+		MaskBits:    intPointer(m.MaskBits),
+		MaskBitsV6:  intPointer(m.MaskBitsV6),
+		Scheduler:   m.Scheduler.ValueString(),
+		AQM:         m.AQM.ValueString(),
+		QueueLimit:  intPointer(m.QueueLimit),
+		ECN:         m.ECN.ValueBool(),
+		Delay:       int(m.Delay.ValueInt64()),
+		Queues:      []pfsense_rest_v2.PFSenseTrafficLimiterQueue{},
+	}
+	for _, b := range m.Bandwidths {
+		limiter.Bandwidths = append(limiter.Bandwidths, pfsense_rest_v2.PFSenseTrafficLimiterBandwidth{
+			Bandwidth: int(b.Bandwidth.ValueInt64()),
+			Scale:     b.Scale.ValueString(),
+			Schedule:  b.Schedule.ValueStringPointer(),
+		})
+	}
+	for _, q := range m.Queues {
+		limiter.Queues = append(limiter.Queues, pfsense_rest_v2.PFSenseTrafficLimiterQueue{
+			Name:        q.Name.ValueString(),
+			Enabled:     q.Enabled.ValueBool(),
+			Description: q.Description.ValueString(),
+			Mask:        q.Mask.ValueString(),
+			MaskBits:    intPointer(q.MaskBits),
+			MaskBitsV6:  intPointer(q.MaskBitsV6),
+			Weight:      intPointer(q.Weight),
+			AQM:         q.AQM.ValueString(),
+			QueueLimit:  intPointer(q.QueueLimit),
+			ECN:         q.ECN.ValueBool(),
+		})
+	}
+	return limiter
+}
+
+func (m *TrafficLimiterResourceModel) fromDomain(limiter *pfsense_rest_v2.PFSenseTrafficLimiter) {
+	m.ID = types.Int64Value(int64(limiter.ID))
+	m.Name = types.StringValue(limiter.Name)
+	m.Enabled = types.BoolValue(limiter.Enabled)
+	m.Description = types.StringValue(limiter.Description)
+	m.Mask = types.StringValue(limiter.Mask)
+	m.MaskBits = int64PointerValue(limiter.MaskBits)
+	m.MaskBitsV6 = int64PointerValue(limiter.MaskBitsV6)
+	m.Scheduler = types.StringValue(limiter.Scheduler)
+	m.AQM = types.StringValue(limiter.AQM)
+	m.QueueLimit = int64PointerValue(limiter.QueueLimit)
+	m.ECN = types.BoolValue(limiter.ECN)
+	m.Delay = types.Int64Value(int64(limiter.Delay))
+	m.Bandwidths = []TrafficLimiterBandwidthModel{}
+	for _, b := range limiter.Bandwidths {
+		m.Bandwidths = append(m.Bandwidths, TrafficLimiterBandwidthModel{
+			Bandwidth: types.Int64Value(int64(b.Bandwidth)),
+			Scale:     types.StringValue(b.Scale),
+			Schedule:  types.StringPointerValue(b.Schedule),
+		})
+	}
+	m.Queues = []TrafficLimiterQueueModel{}
+	for _, q := range limiter.Queues {
+		m.Queues = append(m.Queues, TrafficLimiterQueueModel{
+			Name:        types.StringValue(q.Name),
+			Enabled:     types.BoolValue(q.Enabled),
+			Description: types.StringValue(q.Description),
+			Mask:        types.StringValue(q.Mask),
+			MaskBits:    int64PointerValue(q.MaskBits),
+			MaskBitsV6:  int64PointerValue(q.MaskBitsV6),
+			Weight:      int64PointerValue(q.Weight),
+			AQM:         types.StringValue(q.AQM),
+			QueueLimit:  int64PointerValue(q.QueueLimit),
+			ECN:         types.BoolValue(q.ECN),
+		})
+	}
+}
+
+func (r *TrafficLimiterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_limiter"
+}
+
+// limiterMaskAttributes returns the mask attributes shared by limiters and
+// their queues.
+func limiterMaskAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"mask": schema.StringAttribute{
+			MarkdownDescription: "Give each source (`srcaddress`) or destination (`dstaddress`) address its own pipe of the full bandwidth, instead of sharing one pipe (`none`)",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("none"),
+			Validators:          []validator.String{stringvalidator.OneOf("none", "srcaddress", "dstaddress")},
+		},
+		"mask_bits": schema.Int64Attribute{
+			MarkdownDescription: "IPv4 prefix length addresses are grouped by when `mask` is set",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(32),
+			Validators:          []validator.Int64{int64validator.Between(1, 32)},
+		},
+		"mask_bits_v6": schema.Int64Attribute{
+			MarkdownDescription: "IPv6 prefix length addresses are grouped by when `mask` is set",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(128),
+			Validators:          []validator.Int64{int64validator.Between(1, 128)},
+		},
+	}
+}
+
+func (r *TrafficLimiterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	aqms := []string{"droptail", "codel", "pie", "red", "gred"}
+
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "pfSense ID of the limiter. This is the limiter's position in the configuration and may change when other limiters are removed; the provider locates the limiter by `name` and records the new ID.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Limiter name, as referenced by the `dnpipe` and `pdnpipe` attributes of rules",
+			Required:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the limiter is enabled",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Limiter description",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"bandwidths": schema.ListNestedAttribute{
+			MarkdownDescription: "Bandwidth limits. A limit with a `schedule` only applies while the schedule is active; the limit without one applies otherwise.",
+			Required:            true,
+			Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"bandwidth": schema.Int64Attribute{
+						MarkdownDescription: "Bandwidth, in units of `scale`",
+						Required:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"scale": schema.StringAttribute{
+						MarkdownDescription: "Unit of `bandwidth`: `b`, `Kb` or `Mb` per second",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("Mb"),
+						Validators:          []validator.String{stringvalidator.OneOf("b", "Kb", "Mb")},
+					},
+					"schedule": schema.StringAttribute{
+						MarkdownDescription: "Name of the `pfsense-v2_firewall_schedule` during which this limit applies",
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"scheduler": schema.StringAttribute{
+			MarkdownDescription: "Dummynet scheduler. Supported values: wf2q+, fifo, qfq, rr, prio, fq_codel, fq_pie.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("wf2q+"),
+			Validators:          []validator.String{stringvalidator.OneOf("wf2q+", "fifo", "qfq", "rr", "prio", "fq_codel", "fq_pie")},
+		},
+		"aqm": schema.StringAttribute{
+			MarkdownDescription: "Active queue management algorithm. Supported values: droptail, codel, pie, red, gred.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("droptail"),
+			Validators:          []validator.String{stringvalidator.OneOf(aqms...)},
+		},
+		"queue_limit": schema.Int64Attribute{
+			MarkdownDescription: "Queue length in slots. Leave unset for the dummynet default.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"ecn": schema.BoolAttribute{
+			MarkdownDescription: "Whether to mark packets with ECN instead of dropping them, where the AQM supports it",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"delay": schema.Int64Attribute{
+			MarkdownDescription: "Delay added to traffic, in milliseconds",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+			Validators:          []validator.Int64{int64validator.Between(0, 10000)},
+		},
+	}
+	for name, attribute := range limiterMaskAttributes() {
+		attributes[name] = attribute
+	}
+
+	queueAttributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Queue name, as referenced by the `dnpipe` and `pdnpipe` attributes of rules",
+			Required:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the queue is enabled",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Queue description",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"weight": schema.Int64Attribute{
+			MarkdownDescription: "Share of the limiter's bandwidth relative to its other queues, from 1 to 100. Leave unset for an equal share.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.Between(1, 100)},
+		},
+		"aqm": schema.StringAttribute{
+			MarkdownDescription: "Active queue management algorithm. Supported values: droptail, codel, pie, red, gred.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("droptail"),
+			Validators:          []validator.String{stringvalidator.OneOf(aqms...)},
+		},
+		"queue_limit": schema.Int64Attribute{
+			MarkdownDescription: "Queue length in slots. Leave unset for the dummynet default.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"ecn": schema.BoolAttribute{
+			MarkdownDescription: "Whether to mark packets with ECN instead of dropping them, where the AQM supports it",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+	for name, attribute := range limiterMaskAttributes() {
+		queueAttributes[name] = attribute
+	}
+
+	attributes["queues"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Queues sharing the limiter's bandwidth by weight",
+		Optional:            true,
+		Computed:            true,
+		Default: listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{
+			"name":         types.StringType,
+			"enabled":      types.BoolType,
+			"description":  types.StringType,
+			"mask":         types.StringType,
+			"mask_bits":    types.Int64Type,
+			"mask_bits_v6": types.Int64Type,
+			"weight":       types.Int64Type,
+			"aqm":          types.StringType,
+			"queue_limit":  types.Int64Type,
+			"ecn":          types.BoolType,
+		}}, []attr.Value{})),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: queueAttributes,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Traffic limiter (dummynet pipe). Rules pass traffic through a limiter, or one of its queues, with their `dnpipe` and `pdnpipe` attributes. Can be imported by pfSense ID or by limiter name.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *TrafficLimiterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrafficLimiterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TrafficLimiterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limiter, err := r.client.CreateTrafficLimiter(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create traffic limiter, got error: %s", err))
+		return
+	}
+	data.fromDomain(limiter)
+
+	tflog.Trace(ctx, "created a traffic limiter", map[string]interface{}{"id": limiter.ID, "name": limiter.Name})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the limiter
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *TrafficLimiterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TrafficLimiterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limiter, drift, err := r.client.ResolveTrafficLimiter(int(data.ID.ValueInt64()), data.Name.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic limiter, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(limiter)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrafficLimiterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TrafficLimiterResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "traffic limiter", func() (*pfsense_rest_v2.PFSenseTrafficLimiter, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveTrafficLimiter(int(state.ID.ValueInt64()), state.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	limiter, err := r.client.UpdateTrafficLimiter(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update traffic limiter, got error: %s", err))
+		return
+	}
+	data.fromDomain(limiter)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *TrafficLimiterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TrafficLimiterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "traffic limiter", func() (*pfsense_rest_v2.PFSenseTrafficLimiter, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveTrafficLimiter(int(data.ID.ValueInt64()), data.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteTrafficLimiter(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete traffic limiter, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *TrafficLimiterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Limiter names cannot be purely numeric, so anything that is not a number is a name.
+	_, value := importKey(req.ID, "name")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		limiters, err := r.client.GetTrafficLimiters()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic limiters, got error: %s", err))
+			return
+		}
+		limiter, err := findUnique(limiters, func(limiter *pfsense_rest_v2.PFSenseTrafficLimiter) bool {
+			return limiter.Name == value
+		}, fmt.Sprintf("name %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Traffic Limiter", err.Error())
+			return
+		}
+		id = limiter.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
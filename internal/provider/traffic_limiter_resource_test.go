@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTrafficLimiterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, with a rule sending traffic through the limiter
+			{
+				Config: testAccTrafficLimiterResourceConfig(10),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_traffic_limiter.test",
+						tfjsonpath.New("bandwidths").AtSliceIndex(0).AtMapKey("bandwidth"),
+						knownvalue.Int64Exact(10),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_firewall_rule.test",
+						tfjsonpath.New("dnpipe"),
+						knownvalue.StringExact("tf_acc_test"),
+					),
+				},
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "pfsense-v2_traffic_limiter.test",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_test",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTrafficLimiterResourceConfig(20),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_traffic_limiter.test",
+						tfjsonpath.New("bandwidths").AtSliceIndex(0).AtMapKey("bandwidth"),
+						knownvalue.Int64Exact(20),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTrafficLimiterResourceConfig(bandwidth int) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_traffic_limiter" "test" {
+  name       = "tf_acc_test"
+  bandwidths = [{ bandwidth = %[1]d }]
+}
+
+resource "pfsense-v2_firewall_rule" "test" {
+  type        = "pass"
+  interfaces  = ["lan"]
+  description = "tf_acc_test limited rule"
+  dnpipe      = pfsense-v2_traffic_limiter.test.name
+}
+`, bandwidth)
+}
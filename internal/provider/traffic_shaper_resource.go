@@ -0,0 +1,487 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TrafficShaperResource{}
+var _ resource.ResourceWithImportState = &TrafficShaperResource{}
+var _ resource.ResourceWithValidateConfig = &TrafficShaperResource{}
+
+func NewTrafficShaperResource() resource.Resource {
+	return &TrafficShaperResource{}
+}
+
+// TrafficShaperResource defines the resource implementation.
+type TrafficShaperResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// TrafficShaperResourceModel describes the resource data model.
+type TrafficShaperResourceModel struct {
+	ID            types.Int64               `tfsdk:"id"`
+	Interface     types.String              `tfsdk:"interface"`
+	Enabled       types.Bool                `tfsdk:"enabled"`
+	Scheduler     types.String              `tfsdk:"scheduler"`
+	Bandwidth     types.Int64               `tfsdk:"bandwidth"`
+	BandwidthType types.String              `tfsdk:"bandwidth_type"`
+	QueueLimit    types.Int64               `tfsdk:"queue_limit"`
+	TBRSize       types.Int64               `tfsdk:"tbr_size"`
+	Queues        []TrafficShaperQueueModel `tfsdk:"queues"`
+}
+
+// TrafficShaperQueueModel describes one entry of queues.
+type TrafficShaperQueueModel struct {
+	Name          types.String `tfsdk:"name"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Description   types.String `tfsdk:"description"`
+	Priority      types.Int64  `tfsdk:"priority"`
+	QueueLimit    types.Int64  `tfsdk:"queue_limit"`
+	Default       types.Bool   `tfsdk:"default"`
+	Bandwidth     types.Int64  `tfsdk:"bandwidth"`
+	BandwidthType types.String `tfsdk:"bandwidth_type"`
+	RED           types.Bool   `tfsdk:"red"`
+	RIO           types.Bool   `tfsdk:"rio"`
+	ECN           types.Bool   `tfsdk:"ecn"`
+	CoDel         types.Bool   `tfsdk:"codel"`
+}
+
+func (m *TrafficShaperResourceModel) toDomain() *pfsense_rest_v2.PFSenseTrafficShaper {
+	shaper := &pfsense_rest_v2.PFSenseTrafficShaper{
+		ID:            int(m.ID.ValueInt64()),
+		Interface:     m.Interface.ValueString(),
+		Enabled:       m.Enabled.ValueBool(),
+		Scheduler:     m.Scheduler.ValueString(),
+		Bandwidth:     int(m.Bandwidth.ValueInt64()),
+		BandwidthType: m.BandwidthType.ValueString(),
+		QueueLimit:    intPointer(m.QueueLimit),
+		TBRSize:       intPointer(m.TBRSize),
+		Queues:        []pfsense_rest_v2.PFSenseTrafficShaperQueue{},
+	}
+	for _, q := range m.Queues {
+		shaper.Queues = append(shaper.Queues, pfsense_rest_v2.PFSenseTrafficShaperQueue{
+			Name:          q.Name.ValueString(),
+			Enabled:       q.Enabled.ValueBool(),
+			Description:   q.Description.ValueString(),
+			Priority:      intPointer(q.Priority),
+			QueueLimit:    intPointer(q.QueueLimit),
+			Default:       q.Default.ValueBool(),
+			Bandwidth:     intPointer(q.Bandwidth),
+			BandwidthType: q.BandwidthType.ValueStringPointer(),
+			RED:           q.RED.ValueBool(),
+			RIO:           q.RIO.ValueBool(),
+			ECN:           q.ECN.ValueBool(),
+			CoDel:         q.CoDel.ValueBool(),
+		})
+	}
+	return shaper
+}
+
+func (m *TrafficShaperResourceModel) fromDomain(shaper *pfsense_rest_v2.PFSenseTrafficShaper) {
+	m.ID = types.Int64Value(int64(shaper.ID))
+	m.Interface = types.StringValue(shaper.Interface)
+	m.Enabled = types.BoolValue(shaper.Enabled)
+	m.Scheduler = types.StringValue(shaper.Scheduler)
+	m.Bandwidth = types.Int64Value(int64(shaper.Bandwidth))
+	m.BandwidthType = types.StringValue(shaper.BandwidthType)
+	m.QueueLimit = int64PointerValue(shaper.QueueLimit)
+	m.TBRSize = int64PointerValue(shaper.TBRSize)
+	m.Queues = []TrafficShaperQueueModel{}
+	for _, q := range shaper.Queues {
+		m.Queues = append(m.Queues, TrafficShaperQueueModel{
+			Name:          types.StringValue(q.Name),
+			Enabled:       types.BoolValue(q.Enabled),
+			Description:   types.StringValue(q.Description),
+			Priority:      int64PointerValue(q.Priority),
+			QueueLimit:    int64PointerValue(q.QueueLimit),
+			Default:       types.BoolValue(q.Default),
+			Bandwidth:     int64PointerValue(q.Bandwidth),
+			BandwidthType: types.StringPointerValue(q.BandwidthType),
+			RED:           types.BoolValue(q.RED),
+			RIO:           types.BoolValue(q.RIO),
+			ECN:           types.BoolValue(q.ECN),
+			CoDel:         types.BoolValue(q.CoDel),
+		})
+	}
+}
+
+func (r *TrafficShaperResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_shaper"
+}
+
+func (r *TrafficShaperResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	bandwidthTypes := []string{"%", "b", "Kb", "Mb", "Gb"}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ALTQ traffic shaper of one interface. Rules assign traffic to its queues with their `defaultqueue` attribute. Can be imported by pfSense ID or by interface.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "pfSense ID of the shaper. This is the shaper's position in the configuration and may change when other shapers are removed; the provider locates the shaper by `interface` and records the new ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface the shaper applies to. Each interface has at most one shaper.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the shaper is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"scheduler": schema.StringAttribute{
+				MarkdownDescription: "ALTQ scheduler. Supported values: HFSC, CBQ, FAIRQ, CODELQ, PRIQ.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("HFSC", "CBQ", "FAIRQ", "CODELQ", "PRIQ")},
+			},
+			"bandwidth": schema.Int64Attribute{
+				MarkdownDescription: "Bandwidth of the interface, in units of `bandwidth_type`",
+				Required:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"bandwidth_type": schema.StringAttribute{
+				MarkdownDescription: "Unit of `bandwidth`: `%` of the link speed, or `b`, `Kb`, `Mb` or `Gb` per second",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Mb"),
+				Validators:          []validator.String{stringvalidator.OneOf(bandwidthTypes...)},
+			},
+			"queue_limit": schema.Int64Attribute{
+				MarkdownDescription: "Queue length in packets. Leave unset for the ALTQ default.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"tbr_size": schema.Int64Attribute{
+				MarkdownDescription: "Token bucket regulator size in bytes. Leave unset to let ALTQ choose.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"queues": schema.ListNestedAttribute{
+				MarkdownDescription: "Queues of the shaper. Except with the CODELQ scheduler, exactly one queue must be the `default` queue.",
+				Optional:            true,
+				Computed:            true,
+				Default: listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{
+					"name":           types.StringType,
+					"enabled":        types.BoolType,
+					"description":    types.StringType,
+					"priority":       types.Int64Type,
+					"queue_limit":    types.Int64Type,
+					"default":        types.BoolType,
+					"bandwidth":      types.Int64Type,
+					"bandwidth_type": types.StringType,
+					"red":            types.BoolType,
+					"rio":            types.BoolType,
+					"ecn":            types.BoolType,
+					"codel":          types.BoolType,
+				}}, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Queue name, as referenced by the `defaultqueue` attribute of rules",
+							Required:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the queue is enabled",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Queue description",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Queue priority, from 0 (lowest) to 15. Leave unset for the scheduler default.",
+							Optional:            true,
+							Validators:          []validator.Int64{int64validator.Between(0, 15)},
+						},
+						"queue_limit": schema.Int64Attribute{
+							MarkdownDescription: "Queue length in packets. Leave unset for the ALTQ default.",
+							Optional:            true,
+							Validators:          []validator.Int64{int64validator.AtLeast(1)},
+						},
+						"default": schema.BoolAttribute{
+							MarkdownDescription: "Whether traffic no rule assigns to a queue goes to this queue",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"bandwidth": schema.Int64Attribute{
+							MarkdownDescription: "Bandwidth of the queue, in units of `bandwidth_type`. Leave unset for no bandwidth share.",
+							Optional:            true,
+							Validators:          []validator.Int64{int64validator.AtLeast(1)},
+						},
+						"bandwidth_type": schema.StringAttribute{
+							MarkdownDescription: "Unit of `bandwidth`: `%` of the parent, or `b`, `Kb`, `Mb` or `Gb` per second. Required with `bandwidth`.",
+							Optional:            true,
+							Validators:          []validator.String{stringvalidator.OneOf(bandwidthTypes...)},
+						},
+						"red": schema.BoolAttribute{
+							MarkdownDescription: "Whether to use random early detection",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"rio": schema.BoolAttribute{
+							MarkdownDescription: "Whether to use random early detection in and out",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"ecn": schema.BoolAttribute{
+							MarkdownDescription: "Whether to use explicit congestion notification",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"codel": schema.BoolAttribute{
+							MarkdownDescription: "Whether to use CoDel active queue management",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TrafficShaperResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var scheduler types.String
+	var queues types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("scheduler"), &scheduler)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("queues"), &queues)...)
+
+	if resp.Diagnostics.HasError() || queues.IsUnknown() {
+		return
+	}
+
+	defaults, defaultsKnown := 0, true
+	for i, element := range queues.Elements() {
+		queue, ok := element.(types.Object)
+		if !ok || queue.IsUnknown() {
+			defaultsKnown = false
+			continue
+		}
+		attrs := queue.Attributes()
+
+		isDefault, _ := attrs["default"].(types.Bool)
+		if isDefault.IsUnknown() {
+			defaultsKnown = false
+		} else if isDefault.ValueBool() {
+			defaults++
+		}
+
+		bandwidth, _ := attrs["bandwidth"].(types.Int64)
+		bandwidthType, _ := attrs["bandwidth_type"].(types.String)
+		if !bandwidth.IsUnknown() && !bandwidthType.IsUnknown() && bandwidth.IsNull() != bandwidthType.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("queues").AtListIndex(i).AtName("bandwidth_type"),
+				"Invalid Attribute Combination",
+				"`bandwidth` and `bandwidth_type` must be set together.",
+			)
+		}
+	}
+
+	if scheduler.IsUnknown() || scheduler.ValueString() == "CODELQ" || !defaultsKnown {
+		return
+	}
+	if defaults != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("queues"),
+			"Invalid Default Queue",
+			fmt.Sprintf("Exactly one queue must have `default = true` with the %s scheduler, got %d.", scheduler.ValueString(), defaults),
+		)
+	}
+}
+
+func (r *TrafficShaperResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrafficShaperResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TrafficShaperResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shaper, err := r.client.CreateTrafficShaper(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create traffic shaper, got error: %s", err))
+		return
+	}
+	data.fromDomain(shaper)
+
+	tflog.Trace(ctx, "created a traffic shaper", map[string]interface{}{"id": shaper.ID, "interface": shaper.Interface})
+
+	// Save data into Terraform state before applying so a failed apply does not orphan the shaper
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *TrafficShaperResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TrafficShaperResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shaper, drift, err := r.client.ResolveTrafficShaper(int(data.ID.ValueInt64()), data.Interface.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic shaper, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(shaper)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrafficShaperResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TrafficShaperResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "traffic shaper", func() (*pfsense_rest_v2.PFSenseTrafficShaper, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveTrafficShaper(int(state.ID.ValueInt64()), state.Interface.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	shaper, err := r.client.UpdateTrafficShaper(data.toDomain())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update traffic shaper, got error: %s", err))
+		return
+	}
+	data.fromDomain(shaper)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *TrafficShaperResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TrafficShaperResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "traffic shaper", func() (*pfsense_rest_v2.PFSenseTrafficShaper, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveTrafficShaper(int(data.ID.ValueInt64()), data.Interface.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteTrafficShaper(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete traffic shaper, got error: %s", err))
+		return
+	}
+
+	if err := r.client.ApplyFirewall(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply firewall changes, got error: %s", err))
+	}
+}
+
+func (r *TrafficShaperResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Interface IDs are never numeric, so anything that is not a number is an interface.
+	_, value := importKey(req.ID, "interface")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		shapers, err := r.client.GetTrafficShapers()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic shapers, got error: %s", err))
+			return
+		}
+		shaper, err := findUnique(shapers, func(shaper *pfsense_rest_v2.PFSenseTrafficShaper) bool {
+			return shaper.Interface == value
+		}, fmt.Sprintf("interface %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import Traffic Shaper", err.Error())
+			return
+		}
+		id = shaper.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	pfsense_rest_v2 "terraform-provider-pfsense-v2/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+// userExpiresPattern matches the MM/DD/YYYY dates pfSense uses for account expiry.
+var userExpiresPattern = regexp.MustCompile(`^(0[1-9]|1[0-2])/(0[1-9]|[12][0-9]|3[01])/[0-9]{4}$`)
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	client *pfsense_rest_v2.PFSenseClientV2
+}
+
+// UserResourceModel describes the resource data model.
+type UserResourceModel struct {
+	ID                types.Int64    `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	PasswordWO        types.String   `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64    `tfsdk:"password_wo_version"`
+	Groups            []types.String `tfsdk:"groups"`
+	Privileges        []types.String `tfsdk:"privileges"`
+	AuthorizedKeys    []types.String `tfsdk:"authorized_keys"`
+	Expires           types.String   `tfsdk:"expires"`
+	Disabled          types.Bool     `tfsdk:"disabled"`
+	Description       types.String   `tfsdk:"description"`
+}
+
+// toDomain builds the user without a password; callers add it only when it
+// has to be sent.
+func (m *UserResourceModel) toDomain() *pfsense_rest_v2.PFSenseUser {
+	return &pfsense_rest_v2.PFSenseUser{
+		ID:             int(m.ID.ValueInt64()),
+		Name:           m.Name.ValueString(),
+		Privileges:     stringsFromValues(m.Privileges),
+		Disabled:       m.Disabled.ValueBool(),
+		Description:    m.Description.ValueString(),
+		Expires:        m.Expires.ValueString(),
+		AuthorizedKeys: stringsFromValues(m.AuthorizedKeys),
+	}
+}
+
+// fromDomain copies the user into the model. The password cannot be read back,
+// so the password attributes are left as they are.
+func (m *UserResourceModel) fromDomain(user *pfsense_rest_v2.PFSenseUser) {
+	m.ID = types.Int64Value(int64(user.ID))
+	m.Name = types.StringValue(user.Name)
+	m.Privileges = stringValues(user.Privileges)
+	m.Disabled = types.BoolValue(user.Disabled)
+	m.Description = types.StringValue(user.Description)
+	m.Expires = types.StringValue(user.Expires)
+	m.AuthorizedKeys = stringValues(user.AuthorizedKeys)
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptyList := listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{}))
+
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "pfSense ID of the user. This is the user's position in the configuration and may change when other users are removed; the provider locates the user by `name` and records the new ID.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "User name. Changing it replaces the user.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"groups": schema.ListAttribute{
+			MarkdownDescription: "Names of the groups the user is a member of. The groups must exist. Leave unset to manage membership with the `members` attribute of `pfsense-v2_group` instead; setting both for the same group makes them fight.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"privileges": schema.ListAttribute{
+			MarkdownDescription: "Privileges granted to the user directly, e.g. `page-all`. Group privileges are not listed here.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             emptyList,
+		},
+		"authorized_keys": schema.ListAttribute{
+			MarkdownDescription: "SSH public keys the user can log in with, one `authorized_keys` line each",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             emptyList,
+		},
+		"expires": schema.StringAttribute{
+			MarkdownDescription: "Last day the account can log in, as `MM/DD/YYYY`. Leave empty for no expiry.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+			Validators: []validator.String{
+				stringvalidator.Any(
+					stringvalidator.OneOf(""),
+					stringvalidator.RegexMatches(userExpiresPattern, "must be a date as MM/DD/YYYY"),
+				),
+			},
+		},
+		"disabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the account is disabled",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Full name or description of the user",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+	}
+	for name, attribute := range writeOnlyAttributes("password", "Password") {
+		attributes[name] = attribute
+	}
+	// A user cannot be created without a password, and the password is never
+	// kept in state, so password_wo is the only way to set it.
+	password := attributes["password_wo"].(schema.StringAttribute)
+	password.Optional = false
+	password.Required = true
+	attributes["password_wo"] = password
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Local user account. Can be imported by pfSense ID or by user name; the password is not imported. " +
+			"The password is given in plain text: the REST API hashes whatever it receives as the password, so a precomputed hash cannot be set through it. " +
+			"It is only taken through the write-only `password_wo`, so it never reaches Terraform state; this requires Terraform 1.11 or later.",
+		Attributes: attributes,
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pfsense_rest_v2.PFSenseClientV2)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pfsense_rest_v2.PFSenseClientV2, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := data.toDomain()
+	user.Password = writeOnlyValue(ctx, req.Config, "password", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if user.Password == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Missing Password", "`password_wo` must not be empty.")
+		return
+	}
+
+	user, err := r.client.CreateUser(user)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s", err))
+		return
+	}
+	data.fromDomain(user)
+
+	tflog.Trace(ctx, "created a user", map[string]interface{}{"id": user.ID, "name": user.Name})
+
+	// Save data into Terraform state before setting groups so a failure does not orphan the user
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	r.setGroups(&data, &resp.Diagnostics)
+}
+
+// setGroups updates group membership when the user manages it.
+func (r *UserResource) setGroups(data *UserResourceModel, diags *diag.Diagnostics) {
+	if data.Groups == nil {
+		return
+	}
+	if err := r.client.SetUserGroups(data.Name.ValueString(), stringsFromValues(data.Groups)); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update user groups, got error: %s", err))
+	}
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, drift, err := r.client.ResolveUser(int(data.ID.ValueInt64()), data.Name.ValueString())
+	if errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+	addIDDriftWarning(&resp.Diagnostics, drift)
+	data.fromDomain(user)
+
+	if data.Groups != nil {
+		groups, err := r.client.UserGroupNames(user.Name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user groups, got error: %s", err))
+			return
+		}
+		data.Groups = stringValues(groups)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserResourceModel
+
+	// Read Terraform plan data and prior state into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForUpdate(&resp.Diagnostics, "user", func() (*pfsense_rest_v2.PFSenseUser, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveUser(int(state.ID.ValueInt64()), state.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+	data.ID = types.Int64Value(int64(current.ID))
+
+	// The password is only sent when it changed, since it cannot be compared with the device
+	user := data.toDomain()
+	if writeOnlyVersionChanged(data.PasswordWOVersion, state.PasswordWOVersion) {
+		user.Password = writeOnlyValue(ctx, req.Config, "password", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	user, err := r.client.UpdateUser(user)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user, got error: %s", err))
+		return
+	}
+	data.fromDomain(user)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	r.setGroups(&data, &resp.Diagnostics)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, ok := resolveForDelete(&resp.Diagnostics, "user", func() (*pfsense_rest_v2.PFSenseUser, *pfsense_rest_v2.IDDrift, error) {
+		return r.client.ResolveUser(int(data.ID.ValueInt64()), data.Name.ValueString())
+	})
+	if !ok {
+		return
+	}
+
+	err := r.client.DeleteUser(current.ID)
+	if err != nil && !errors.Is(err, pfsense_rest_v2.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user, got error: %s", err))
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// User names must start with a letter, so anything that is not a number is a name.
+	_, value := importKey(req.ID, "name")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		users, err := r.client.GetUsers()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
+			return
+		}
+		user, err := findUnique(users, func(user *pfsense_rest_v2.PFSenseUser) bool {
+			return user.Name == value
+		}, fmt.Sprintf("name %q", value))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Import User", err.Error())
+			return
+		}
+		id = user.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Write-only attributes need Terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing, with membership managed by the user
+			{
+				Config: testAccUserResourceConfig(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_user.test",
+						tfjsonpath.New("groups"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("tf_acc_test")}),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_group.test",
+						tfjsonpath.New("members"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_user.test",
+						tfjsonpath.New("password_wo"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// ImportState testing by name; the password version and managed groups cannot be imported
+			{
+				ResourceName:            "pfsense-v2_user.test",
+				ImportState:             true,
+				ImportStateId:           "tf_acc_test",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_wo_version", "groups"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccUserResourceConfig(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"pfsense-v2_user.test",
+						tfjsonpath.New("disabled"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUserResourceConfig(disabled bool) string {
+	return fmt.Sprintf(`
+resource "pfsense-v2_group" "test" {
+  name       = "tf_acc_test"
+  privileges = ["page-dashboard-all"]
+}
+
+resource "pfsense-v2_user" "test" {
+  name     = "tf_acc_test"
+  groups   = [pfsense-v2_group.test.name]
+  disabled = %[1]t
+
+  password_wo         = "tf-acc-test-password"
+  password_wo_version = 1
+}
+`, disabled)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Secrets are taken through write-only attributes, which Terraform passes to
+// the provider from the configuration but never stores in plan or state, so
+// they can be fed from ephemeral values. Since the provider cannot tell
+// whether a secret it never sees again has changed, each `<name>_wo`
+// attribute comes with a `<name>_wo_version` attribute: the secret is sent
+// when the object is created and whenever the version changes.
+
+// writeOnlyAttributes returns the `<name>_wo` and `<name>_wo_version`
+// attributes of a secret described by description.
+func writeOnlyAttributes(name string, description string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		name + "_wo": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("%s, never stored in Terraform state (requires Terraform 1.11 or later). "+
+				"It is only sent when the object is created and when `%s_wo_version` changes.", description, name),
+			Optional:  true,
+			Sensitive: true,
+			WriteOnly: true,
+		},
+		name + "_wo_version": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Change this value to send `%s_wo` again", name),
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot(name + "_wo")),
+			},
+		},
+	}
+}
+
+// writeOnlyValue returns the secret `<name>_wo` from the configuration, the
+// only place Terraform passes it.
+func writeOnlyValue(ctx context.Context, config tfsdk.Config, name string, diags *diag.Diagnostics) string {
+	var value types.String
+	diags.Append(config.GetAttribute(ctx, path.Root(name+"_wo"), &value)...)
+	return value.ValueString()
+}
+
+// writeOnlyVersionChanged reports whether an update has to send the secret
+// again.
+func writeOnlyVersionChanged(plan types.Int64, state types.Int64) bool {
+	return !plan.Equal(state)
+}
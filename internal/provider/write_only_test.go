@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWriteOnlyAttributes(t *testing.T) {
+	attributes := writeOnlyAttributes("password", "Password")
+
+	secret, ok := attributes["password_wo"].(schema.StringAttribute)
+	if !ok || !secret.WriteOnly || !secret.Sensitive || secret.Computed {
+		t.Errorf("expected an optional sensitive write-only secret, got %+v", attributes["password_wo"])
+	}
+	if version, ok := attributes["password_wo_version"].(schema.Int64Attribute); !ok || version.WriteOnly {
+		t.Errorf("expected a stored version, got %+v", attributes["password_wo_version"])
+	}
+}
+
+func TestWriteOnlyVersionChanged(t *testing.T) {
+	tests := []struct {
+		plan, state types.Int64
+		want        bool
+	}{
+		{types.Int64Null(), types.Int64Null(), false},
+		{types.Int64Value(1), types.Int64Value(1), false},
+		{types.Int64Value(2), types.Int64Value(1), true},
+		{types.Int64Value(1), types.Int64Null(), true},
+		{types.Int64Null(), types.Int64Value(1), true},
+	}
+	for _, test := range tests {
+		if got := writeOnlyVersionChanged(test.plan, test.state); got != test.want {
+			t.Errorf("writeOnlyVersionChanged(%s, %s) = %t, want %t", test.plan, test.state, got, test.want)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"terraform-provider-pfsense-v2/internal/provider"
 
@@ -23,6 +24,13 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := runGenerate(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -1,6 +1,6 @@
 #!/usr/bin/env bash
 
-GOOD_TAGS="AUTH FIREWALL INTERFACE SYSTEM SERVICES"
+GOOD_TAGS="AUTH FIREWALL INTERFACE ROUTING STATUS SYSTEM SERVICES USER DIAGNOSTICS"
 
 echo "# yaml-language-server: $schema=https://raw.githubusercontent.com/oapi-codegen/oapi-codegen/HEAD/configuration-schema.json"
 echo "package: pfsense_rest_v2"
@@ -14,8 +14,15 @@ for tag in $GOOD_TAGS; do
     echo "    - $tag"
 done
 
-# Now exclude all SERVICES operations that aren't for static mapping
-before='["^/api/v2/services/dhcp_server$", "^/api/v2/services/dhcp_server/.*$" ] as $keep'
+# Now exclude all SERVICES operations that aren't for static mapping, the DNS
+# resolver or SSH
+before='[
+    "^/api/v2/services/dhcp_server$", "^/api/v2/services/dhcp_server/.*$",
+    "^/api/v2/services/dns_resolver/(host|domain)_overrides?(/.*)?$",
+    "^/api/v2/services/dns_resolver/access_lists?(/.*)?$",
+    "^/api/v2/services/dns_resolver/(apply|settings)$",
+    "^/api/v2/services/ssh$"
+] as $keep'
 from_tag="SERVICES"
 
 echo "  exclude-operation-ids:"